@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/stateful/runme/internal/document"
 	"github.com/stateful/runme/internal/renderer/cmark"
 	"github.com/stateful/runme/internal/runner"
+	"go.uber.org/zap"
 )
 
 func readMarkdownFile(args []string) ([]byte, error) {
@@ -103,26 +105,121 @@ func writeMarkdownFile(args []string, data []byte) error {
 }
 
 func getCodeBlocks() (document.CodeBlocks, error) {
+	blocks, _, err := getCodeBlocksWithFrontmatter()
+	return blocks, err
+}
+
+func getCodeBlocksWithFrontmatter() (document.CodeBlocks, *document.Frontmatter, error) {
 	data, err := readMarkdownFile(nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	doc := document.New(data, cmark.Render)
-	node, _, err := doc.Parse()
-	if err != nil {
-		return nil, err
-	}
+	var (
+		blocks document.CodeBlocks
+		fm     *document.Frontmatter
+	)
+
+	// Documents in other formats than Markdown have no front matter.
+	if format, ok := document.LookupFormat(fFileName); ok {
+		fm = &document.Frontmatter{}
+		blocks, err = format.CodeBlocks(data)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		sections, err := document.ParseSections(data)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		fm, err = document.ParseFrontmatter(sections.FrontMatter)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		root, filename, err := includeRoot()
+		if err != nil {
+			return nil, nil, err
+		}
 
-	blocks := document.CollectCodeBlocks(node)
+		doc := document.New(sections.Content, cmark.NewRenderer(sections.Content)).
+			WithBase(sections.ContentStart).
+			WithFS(os.DirFS(root), filename)
+		node, _, err := doc.Parse()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		blocks = document.CollectCodeBlocks(node)
+	}
 
+	// Detected languages are only guesses, hence, blocks
+	// without a language require --allow-unknown.
 	filtered := make(document.CodeBlocks, 0, len(blocks))
 	for _, b := range blocks {
 		if fAllowUnknown || (b.Language() != "" && runner.IsSupported(b.Language())) {
 			filtered = append(filtered, b)
 		}
 	}
-	return filtered, nil
+	return filtered, fm, nil
+}
+
+// registerInterpreters registers interpreters from the YAML file
+// described in runner.ParseInterpreters. If optional is true,
+// a missing file is ignored.
+func registerInterpreters(filename string, optional bool) error {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		if optional && errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to read interpreters from %s", filename)
+	}
+
+	interpreters, err := runner.ParseInterpreters(data)
+	if err != nil {
+		return errors.Wrapf(err, "invalid file %s", filename)
+	}
+	for lang, interpreter := range interpreters {
+		runner.RegisterInterpreter(lang, interpreter)
+	}
+	return nil
+}
+
+// newSession creates a runner session configured according
+// to the document's front matter. It fails if any of the required
+// tools is missing.
+func newSession(fm *document.Frontmatter) (*runner.Session, error) {
+	if fm == nil {
+		fm = &document.Frontmatter{}
+	}
+
+	var missing []string
+	for _, tool := range fm.Tools {
+		if _, err := exec.LookPath(tool); err != nil {
+			missing = append(missing, tool)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, errors.Errorf("missing required tools: %s", strings.Join(missing, ", "))
+	}
+
+	// The session is seeded with the current environment
+	// so that it can be used to check which variables are set.
+	envs := append(os.Environ(), fm.Envs()...)
+
+	sess := runner.NewSession(envs, zap.NewNop())
+	sess.ProgramName = fm.Shell
+	sess.Dir = fChdir
+	if fm.Cwd != "" {
+		// Like includes, cwd is relative to the document.
+		sess.Dir = fm.Dir(filepath.Join(fChdir, filepath.Dir(fFileName)))
+		if _, err := os.Stat(sess.Dir); os.IsNotExist(err) {
+			return nil, errors.Errorf("working directory %s set by cwd in the front matter does not exist", sess.Dir)
+		}
+	}
+	return sess, nil
 }
 
 func lookupCodeBlock(blocks document.CodeBlocks, name string) (*document.CodeBlock, error) {
@@ -133,6 +230,76 @@ func lookupCodeBlock(blocks document.CodeBlocks, name string) (*document.CodeBlo
 	return block, nil
 }
 
+// lookupCodeBlocks returns a code block with the given ID or name or,
+// if there is none and name is a pattern, all code blocks matching it.
+func lookupCodeBlocks(blocks document.CodeBlocks, name string) (document.CodeBlocks, error) {
+	if block := blocks.Lookup(name); block != nil || !document.IsPattern(name) {
+		block, err := lookupCodeBlock(blocks, name)
+		if err != nil {
+			return nil, err
+		}
+		return document.CodeBlocks{block}, nil
+	}
+
+	result, err := blocks.Match(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid pattern %q", name)
+	}
+	if len(result) == 0 {
+		return nil, errors.Errorf("no commands match %q", name)
+	}
+	return result, nil
+}
+
+// includeRoot returns the directory in which includes are resolved
+// and the name of the markdown file in it. It is the root of the
+// repository containing the file, so that files can include others
+// from parent directories, or, outside of repositories, --chdir.
+func includeRoot() (root, filename string, err error) {
+	chdir, err := filepath.Abs(fChdir)
+	if err != nil {
+		return "", "", errors.WithStack(err)
+	}
+	file := filepath.Join(chdir, fFileName)
+
+	root = chdir
+	for dir := filepath.Dir(file); ; {
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			root = dir
+			break
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+
+	filename, err = filepath.Rel(root, file)
+	if err != nil {
+		return "", "", errors.WithStack(err)
+	}
+	return root, filepath.ToSlash(filename), nil
+}
+
+// blockLocation returns a location of the block in the markdown file
+// in the "file:line" format. Included code blocks report the file
+// they come from, relative to --chdir.
+func blockLocation(block *document.CodeBlock) string {
+	filename := fFileName
+	if block.Filename() != "" {
+		root, _, err := includeRoot()
+		chdir, absErr := filepath.Abs(fChdir)
+		if err == nil && absErr == nil {
+			rel, err := filepath.Rel(chdir, filepath.Join(root, filepath.FromSlash(block.Filename())))
+			if err == nil {
+				filename = rel
+			}
+		}
+	}
+	return fmt.Sprintf("%s:%d", filename, block.Range().Start.Line)
+}
+
 func validCmdNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	blocks, err := getCodeBlocks()
 	if err != nil {
@@ -140,7 +307,7 @@ func validCmdNames(cmd *cobra.Command, args []string, toComplete string) ([]stri
 		return nil, cobra.ShellCompDirectiveError
 	}
 
-	names := blocks.Names()
+	names := append(blocks.Names(), blocks.IDs()...)
 
 	var filtered []string
 	for _, name := range names {
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/stateful/runme/internal/document/editor"
+)
+
+const (
+	convertToMarkdown = "markdown"
+	convertToJupyter  = "ipynb"
+)
+
+func convertCmd() *cobra.Command {
+	var (
+		includeOutputs bool
+		output         string
+		to             string
+	)
+
+	cmd := cobra.Command{
+		Use:   "convert [file]",
+		Short: "Convert between Markdown and Jupyter notebooks",
+		Long:  "Convert converts a Markdown file into a Jupyter notebook (.ipynb) or the other way around. The direction is inferred from the file extension unless --to is provided.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := fFileName
+			if len(args) == 1 {
+				name = args[0]
+			}
+
+			if to == "" {
+				to = convertToJupyter
+				if strings.EqualFold(filepath.Ext(name), ".ipynb") {
+					to = convertToMarkdown
+				}
+			}
+
+			data, err := readMarkdownFile(args)
+			if err != nil {
+				return err
+			}
+
+			var result []byte
+
+			switch to {
+			case convertToJupyter:
+				notebook, err := editor.DeserializeWithOutputs(data)
+				if err != nil {
+					return errors.Wrap(err, "failed to deserialize")
+				}
+				if !includeOutputs {
+					notebook.StripOutputs()
+				}
+				result, err = editor.ToJupyter(notebook)
+				if err != nil {
+					return err
+				}
+			case convertToMarkdown:
+				notebook, err := editor.FromJupyter(data, includeOutputs)
+				if err != nil {
+					return err
+				}
+				result, err = editor.Serialize(notebook)
+				if err != nil {
+					return errors.Wrap(err, "failed to serialize")
+				}
+			default:
+				return errors.Errorf("invalid value %q of --to: expected %s or %s", to, convertToMarkdown, convertToJupyter)
+			}
+
+			if output != "" {
+				return errors.Wrapf(os.WriteFile(output, result, 0o644), "failed to write to %s", output)
+			}
+
+			_, err = cmd.OutOrStdout().Write(result)
+			return errors.Wrap(err, "failed to write out result")
+		},
+	}
+
+	setDefaultFlags(&cmd)
+
+	cmd.Flags().BoolVar(&includeOutputs, "include-outputs", false, "Include text outputs of code cells.")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Write result to the file instead of stdout.")
+	cmd.Flags().StringVar(&to, "to", "", "Target format: markdown or ipynb. Defaults to markdown for .ipynb files and ipynb otherwise.")
+
+	return &cmd
+}
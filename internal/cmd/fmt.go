@@ -3,8 +3,11 @@ package cmd
 import (
 	"bytes"
 	"encoding/json"
+	"path/filepath"
+	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
 	"github.com/spf13/cobra"
 	"github.com/stateful/runme/internal/document"
 	"github.com/stateful/runme/internal/document/editor"
@@ -13,81 +16,197 @@ import (
 
 func fmtCmd() *cobra.Command {
 	var (
+		check      bool
 		formatJSON bool
 		flatten    bool
 		write      bool
 	)
 
 	cmd := cobra.Command{
-		Use:   "fmt",
+		Use:   "fmt [files...]",
 		Short: "Format a Markdown file into canonical format",
-		Args:  cobra.MaximumNArgs(1),
+		Long:  "Fmt formats Markdown files into canonical format. Files can be provided as arguments, including glob patterns like docs/*.md. Without arguments, the file provided by --filename is formatted.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if formatJSON {
 				if write {
 					return errors.New("invalid usage of --json with --write")
 				}
+				if check {
+					return errors.New("invalid usage of --json with --check")
+				}
 				if !flatten {
 					return errors.New("invalid usage of --json without --flatten")
 				}
 			}
+			if check && write {
+				return errors.New("invalid usage of --check with --write")
+			}
 
-			data, err := readMarkdownFile(args)
+			files, err := expandFileArgs(args)
 			if err != nil {
 				return err
 			}
+			if len(files) > 1 && !write && !check {
+				return errors.New("invalid usage of multiple files without --write or --check")
+			}
 
-			var formatted []byte
+			unformatted := 0
+
+			for _, file := range files {
+				fileArgs := []string{file}
+				if file == "" {
+					fileArgs = nil
+				}
 
-			if flatten {
-				notebook, err := editor.Deserialize(data)
+				data, err := readMarkdownFile(fileArgs)
 				if err != nil {
-					return errors.Wrap(err, "failed to deserialize")
+					return err
 				}
 
-				if formatJSON {
-					var buf bytes.Buffer
-					enc := json.NewEncoder(&buf)
-					enc.SetIndent("", "  ")
-					if err := enc.Encode(notebook); err != nil {
-						return errors.Wrap(err, "failed to encode to JSON")
-					}
-					formatted = buf.Bytes()
-				} else {
-					formatted, err = editor.Serialize(notebook)
+				// Writing assigns stable IDs to code blocks which can be run,
+				// while printing out and checking leave the source as it is.
+				if write {
+					data, err = document.InsertIDs(data)
 					if err != nil {
-						return errors.Wrap(err, "failed to serialize")
+						return errors.Wrap(err, "failed to insert IDs")
 					}
 				}
-			} else {
-				doc := document.New(data, cmark.Render)
-				_, astNode, err := doc.Parse()
+
+				formatted, err := formatMarkdown(data, flatten, formatJSON)
 				if err != nil {
-					return errors.Wrap(err, "failed to parse source")
+					return errors.Wrap(err, displayFilename(file))
 				}
-				formatted, err = cmark.Render(astNode, data)
-				if err != nil {
-					return errors.Wrap(err, "failed to render")
+
+				switch {
+				case check:
+					if bytes.Equal(data, formatted) {
+						continue
+					}
+					unformatted++
+					if err := writeFormatDiff(cmd, displayFilename(file), data, formatted); err != nil {
+						return err
+					}
+				case write:
+					if err := writeMarkdownFile(fileArgs, formatted); err != nil {
+						return err
+					}
+				default:
+					if _, err := cmd.OutOrStdout().Write(formatted); err != nil {
+						return errors.Wrap(err, "failed to write out result")
+					}
 				}
 			}
 
-			if write {
-				err = writeMarkdownFile(args, formatted)
-			} else {
-				_, err = cmd.OutOrStdout().Write(formatted)
-				if err != nil {
-					err = errors.Wrap(err, "failed to write out result")
-				}
+			if unformatted > 0 {
+				return errors.Errorf("%d of %d files are not formatted", unformatted, len(files))
 			}
-			return err
+			return nil
 		},
 	}
 
 	setDefaultFlags(&cmd)
 
+	cmd.Flags().BoolVar(&check, "check", false, "Check if files are formatted and print out a diff of those which are not. Exits with a non-zero status if any file is not formatted.")
 	cmd.Flags().BoolVar(&flatten, "flatten", false, "Flatten nested blocks in the output.")
 	cmd.Flags().BoolVar(&formatJSON, "json", false, "Print out data as JSON. Only possible with --flatten and not allowed with --write.")
-	cmd.Flags().BoolVarP(&write, "write", "w", false, "Write result to the source file instead of stdout.")
+	cmd.Flags().BoolVarP(&write, "write", "w", false, "Write result to the source file instead of stdout. Code blocks which can be run and have no ID get one assigned.")
 
 	return &cmd
 }
+
+// expandFileArgs returns files matching the arguments, which can be
+// glob patterns. Without arguments, it returns a single empty name
+// denoting the file provided by --filename.
+func expandFileArgs(args []string) ([]string, error) {
+	if len(args) == 0 {
+		return []string{""}, nil
+	}
+
+	var files []string
+	for _, arg := range args {
+		if arg == "-" || strings.HasPrefix(arg, "https://") || !strings.ContainsAny(arg, "*?[") {
+			files = append(files, arg)
+			continue
+		}
+
+		matches, err := filepath.Glob(arg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid pattern %q", arg)
+		}
+		if len(matches) == 0 {
+			return nil, errors.Errorf("no files match %q", arg)
+		}
+		files = append(files, matches...)
+	}
+	return files, nil
+}
+
+func displayFilename(file string) string {
+	if file == "" {
+		return filepath.Join(fChdir, fFileName)
+	}
+	return file
+}
+
+func formatMarkdown(data []byte, flatten, formatJSON bool) ([]byte, error) {
+	if flatten {
+		notebook, err := editor.Deserialize(data)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to deserialize")
+		}
+		// Formatting renders all cells, even unchanged ones.
+		notebook.StripSources()
+
+		if formatJSON {
+			var buf bytes.Buffer
+			enc := json.NewEncoder(&buf)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(notebook); err != nil {
+				return nil, errors.Wrap(err, "failed to encode to JSON")
+			}
+			return buf.Bytes(), nil
+		}
+
+		formatted, err := editor.Serialize(notebook)
+		return formatted, errors.Wrap(err, "failed to serialize")
+	}
+
+	doc := document.New(data, cmark.Render)
+	_, astNode, err := doc.Parse()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse source")
+	}
+	formatted, err := cmark.Render(astNode, data)
+	return formatted, errors.Wrap(err, "failed to render")
+}
+
+// writeFormatDiff writes a unified diff between the source and
+// the formatted file which can be applied with "patch -p1".
+func writeFormatDiff(cmd *cobra.Command, name string, data, formatted []byte) error {
+	name = filepath.ToSlash(name)
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(string(data)),
+		B:        splitLines(string(formatted)),
+		FromFile: "a/" + name,
+		ToFile:   "b/" + name,
+		Context:  3,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to diff")
+	}
+	_, err = cmd.OutOrStdout().Write([]byte(diff))
+	return errors.Wrap(err, "failed to write out result")
+}
+
+// splitLines splits s after line breaks. Unlike difflib.SplitLines,
+// it does not add an empty line if s ends with a line break. A last
+// line without one is followed by the marker used by diff and patch.
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	} else {
+		lines[len(lines)-1] += "\n\\ No newline at end of file\n"
+	}
+	return lines
+}
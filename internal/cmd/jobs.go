@@ -0,0 +1,345 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/stateful/runme/internal/runner"
+	"go.uber.org/multierr"
+)
+
+// Jobs of a session live as long as the runme process which started
+// them. Other runme processes, like "runme ps", reach them through
+// a unix socket per session in jobsDir().
+
+func jobsDir() string {
+	return filepath.Join(os.TempDir(), "runme-jobs-"+strconv.Itoa(os.Getuid()))
+}
+
+type jobInfo struct {
+	Session   string    `json:"session"`
+	Name      string    `json:"name"`
+	PID       int       `json:"pid"`
+	Status    string    `json:"status"`
+	StartTime time.Time `json:"startTime"`
+
+	socket string
+}
+
+// jobServer serves jobs of the session. It starts listening
+// once the session has any jobs.
+type jobServer struct {
+	sess     *runner.Session
+	socket   string
+	listener net.Listener
+	server   *http.Server
+}
+
+func newJobServer(sess *runner.Session) *jobServer {
+	return &jobServer{
+		sess:   sess,
+		socket: filepath.Join(jobsDir(), sess.ID+".sock"),
+	}
+}
+
+func (s *jobServer) Serve() error {
+	if s.listener != nil || len(s.sess.Jobs()) == 0 {
+		return nil
+	}
+
+	if err := os.MkdirAll(jobsDir(), 0o700); err != nil {
+		return errors.Wrap(err, "failed to create jobs dir")
+	}
+
+	l, err := net.Listen("unix", s.socket)
+	if err != nil {
+		return errors.Wrap(err, "failed to listen to sock")
+	}
+
+	s.listener = l
+	s.server = &http.Server{
+		Handler:           newJobsHandler(s.sess),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() { _ = s.server.Serve(l) }()
+
+	return nil
+}
+
+// Close ends the session, stopping its jobs, and the server.
+func (s *jobServer) Close() error {
+	err := s.sess.Close()
+	if s.server != nil {
+		// Requests, like the one which stopped the last job,
+		// are completed. The listener removes the socket.
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		err = multierr.Append(err, s.server.Shutdown(ctx))
+	}
+	return err
+}
+
+func newJobsHandler(sess *runner.Session) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/jobs", func(w http.ResponseWriter, r *http.Request) {
+		result := []jobInfo{}
+		for _, job := range sess.Jobs() {
+			result = append(result, jobInfo{
+				Session:   sess.ID,
+				Name:      job.Name,
+				PID:       job.PID,
+				Status:    job.Status(),
+				StartTime: job.StartTime,
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(result)
+	})
+
+	// Handles /jobs/<name>/logs and /jobs/<name>/stop.
+	mux.HandleFunc("/jobs/", func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/jobs/")
+		idx := strings.LastIndex(path, "/")
+		if idx < 0 {
+			http.NotFound(w, r)
+			return
+		}
+
+		job, ok := sess.Job(path[:idx])
+		if !ok {
+			http.Error(w, "job not found", http.StatusNotFound)
+			return
+		}
+
+		switch action := path[idx+1:]; {
+		case action == "logs" && r.Method == http.MethodGet:
+			_, _ = w.Write(job.Output())
+		case action == "stop" && r.Method == http.MethodPost:
+			if err := job.Stop(); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	return mux
+}
+
+func jobsClient(socket string) *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", socket)
+			},
+		},
+	}
+}
+
+// listJobs returns jobs of all sessions. Sockets left behind
+// by runme processes which did not exit cleanly are removed.
+func listJobs(ctx context.Context) ([]jobInfo, error) {
+	sockets, err := filepath.Glob(filepath.Join(jobsDir(), "*.sock"))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var result []jobInfo
+
+	for _, socket := range sockets {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://runme/jobs", nil)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		resp, err := jobsClient(socket).Do(req)
+		if err != nil {
+			if errors.Is(err, syscall.ECONNREFUSED) {
+				_ = os.Remove(socket)
+			}
+			continue
+		}
+
+		var jobs []jobInfo
+		err = json.NewDecoder(resp.Body).Decode(&jobs)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid response from %s", socket)
+		}
+
+		for _, job := range jobs {
+			job.socket = socket
+			result = append(result, job)
+		}
+	}
+
+	return result, nil
+}
+
+// lookupJob returns the job with the given name. If more sessions
+// have such a job, the running one started last is preferred.
+func lookupJob(ctx context.Context, name string) (jobInfo, error) {
+	jobs, err := listJobs(ctx)
+	if err != nil {
+		return jobInfo{}, err
+	}
+
+	var (
+		result jobInfo
+		found  bool
+	)
+
+	for _, job := range jobs {
+		if job.Name != name {
+			continue
+		}
+		if !found {
+			result, found = job, true
+			continue
+		}
+		running, resultRunning := job.Status == "running", result.Status == "running"
+		if (running && !resultRunning) || (running == resultRunning && job.StartTime.After(result.StartTime)) {
+			result = job
+		}
+	}
+
+	if !found {
+		return jobInfo{}, errors.Errorf("unable to find job %q", name)
+	}
+	return result, nil
+}
+
+func requestJob(ctx context.Context, job jobInfo, method, action string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, "http://runme/jobs/"+url.PathEscape(job.Name)+"/"+action, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	resp, err := jobsClient(job.socket).Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to reach job %q", job.Name)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		defer func() { _ = resp.Body.Close() }()
+		msg, _ := io.ReadAll(resp.Body)
+		return nil, errors.Errorf("failed to %s job %q: %s", action, job.Name, strings.TrimSpace(string(msg)))
+	}
+
+	return resp, nil
+}
+
+func psCmd() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "ps",
+		Short: "List background jobs",
+		Long:  "Lists jobs started by commands with the \"background\" attribute, which run as long as runme which started them.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			jobs, err := listJobs(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			io := iostreams.System()
+			//lint:ignore SA1019 utils is deprecated but that's ok for now.
+			table := utils.NewTablePrinter(io)
+
+			table.AddField(strings.ToUpper("Name"), nil, nil)
+			table.AddField(strings.ToUpper("PID"), nil, nil)
+			table.AddField(strings.ToUpper("Status"), nil, nil)
+			table.AddField(strings.ToUpper("Started"), nil, nil)
+			table.EndRow()
+
+			for _, job := range jobs {
+				table.AddField(job.Name, nil, nil)
+				table.AddField(strconv.Itoa(job.PID), nil, nil)
+				table.AddField(job.Status, nil, nil)
+				table.AddField(job.StartTime.Format(time.Stamp), nil, nil)
+				table.EndRow()
+			}
+
+			return errors.Wrap(table.Render(), "failed to render")
+		},
+	}
+
+	setDefaultFlags(&cmd)
+
+	return &cmd
+}
+
+func logsCmd() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "logs <name>",
+		Short: "Print output of a background job",
+		Long:  "Prints the last output of a background job. See \"runme ps\".",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			job, err := lookupJob(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+
+			resp, err := requestJob(cmd.Context(), job, http.MethodGet, "logs")
+			if err != nil {
+				return err
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			_, err = io.Copy(cmd.OutOrStdout(), resp.Body)
+			return errors.WithStack(err)
+		},
+	}
+
+	setDefaultFlags(&cmd)
+
+	return &cmd
+}
+
+func stopCmd() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "stop <name>",
+		Short: "Stop a background job",
+		Long:  "Stops a background job, including processes started by it. See \"runme ps\".",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			job, err := lookupJob(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+
+			resp, err := requestJob(cmd.Context(), job, http.MethodPost, "stop")
+			if err != nil {
+				return err
+			}
+			_ = resp.Body.Close()
+
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "stopped %s\n", job.Name)
+			return errors.WithStack(err)
+		},
+	}
+
+	setDefaultFlags(&cmd)
+
+	return &cmd
+}
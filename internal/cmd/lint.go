@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/stateful/runme/internal/lint"
+	"github.com/stateful/runme/internal/version"
+)
+
+func lintCmd() *cobra.Command {
+	var format string
+
+	cmd := cobra.Command{
+		Use:   "lint [files...]",
+		Short: "Report problems in code blocks of Markdown files",
+		Long:  "Lint reports code blocks without a language, duplicate names, unsupported languages, commands mixed with their output, unterminated line continuations, and invalid attributes. It exits with a non-zero status if any error or warning is found.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if format != "text" && format != "json" && format != "sarif" {
+				return errors.Errorf("invalid format %q; expected text, json, or sarif", format)
+			}
+
+			files := args
+			if len(files) == 0 {
+				files = []string{fFileName}
+			}
+
+			var diagnostics []lint.Diagnostic
+
+			for _, file := range files {
+				path := file
+				if !filepath.IsAbs(path) {
+					path = filepath.Join(fChdir, path)
+				}
+
+				data, err := os.ReadFile(path)
+				if err != nil {
+					return errors.Wrapf(err, "failed to read %s", file)
+				}
+
+				result, err := lint.Lint(file, data)
+				if err != nil {
+					return errors.Wrapf(err, "failed to lint %s", file)
+				}
+				diagnostics = append(diagnostics, result...)
+			}
+
+			var err error
+
+			switch format {
+			case "text":
+				w := bulkWriter{Writer: cmd.OutOrStdout()}
+				for _, d := range diagnostics {
+					w.Write([]byte(d.String() + "\n"))
+				}
+				err = w.Err()
+			case "json":
+				if diagnostics == nil {
+					diagnostics = []lint.Diagnostic{}
+				}
+				err = encodeJSON(cmd, diagnostics)
+			case "sarif":
+				err = encodeJSON(cmd, lint.ToSARIF(diagnostics, version.BuildVersion))
+			}
+			if err != nil {
+				return errors.Wrap(err, "failed to write out result")
+			}
+
+			problems := 0
+			for _, d := range diagnostics {
+				if d.Severity != lint.SeverityNote {
+					problems++
+				}
+			}
+			if problems > 0 {
+				return errors.Errorf("found %d %s", problems, pluralize("problem", problems))
+			}
+			return nil
+		},
+	}
+
+	setDefaultFlags(&cmd)
+
+	cmd.Flags().StringVar(&format, "format", "text", "Output format: text, json, or sarif.")
+
+	return &cmd
+}
+
+func encodeJSON(cmd *cobra.Command, v any) error {
+	enc := json.NewEncoder(cmd.OutOrStdout())
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func pluralize(word string, n int) string {
+	if n == 1 {
+		return word
+	}
+	return fmt.Sprintf("%ss", word)
+}
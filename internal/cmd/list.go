@@ -8,6 +8,7 @@ import (
 	"github.com/cli/cli/v2/utils"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/stateful/runme/internal/document"
 )
 
 func listCmd() *cobra.Command {
@@ -15,7 +16,7 @@ func listCmd() *cobra.Command {
 		Use:     "list",
 		Aliases: []string{"ls"},
 		Short:   "List available commands",
-		Long:    "Displays list of parsed command blocks, their name, number of commands in a block, and description from a given markdown file, such as README.md.",
+		Long:    "Displays list of parsed command blocks, their name, section, language, number of commands in a block, and description from a given markdown file, such as README.md.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			blocks, err := getCodeBlocks()
 			if err != nil {
@@ -29,6 +30,8 @@ func listCmd() *cobra.Command {
 
 			// table header
 			table.AddField(strings.ToUpper("Name"), nil, nil)
+			table.AddField(strings.ToUpper("Section"), nil, nil)
+			table.AddField(strings.ToUpper("Language"), nil, nil)
 			table.AddField(strings.ToUpper("First Command"), nil, nil)
 			table.AddField(strings.ToUpper("# of Commands"), nil, nil)
 			table.AddField(strings.ToUpper("Description"), nil, nil)
@@ -36,9 +39,15 @@ func listCmd() *cobra.Command {
 
 			for _, block := range blocks {
 				lines := block.Lines()
+				firstCommand := ""
+				if len(lines) > 0 {
+					firstCommand = lines[0]
+				}
 
 				table.AddField(block.Name(), nil, nil)
-				table.AddField(lines[0], nil, nil)
+				table.AddField(block.Section(), nil, nil)
+				table.AddField(listLanguage(block), nil, nil)
+				table.AddField(firstCommand, nil, nil)
 				table.AddField(fmt.Sprintf("%d", len(lines)), nil, nil)
 				table.AddField(block.Intro(), nil, nil)
 				table.EndRow()
@@ -52,3 +61,12 @@ func listCmd() *cobra.Command {
 
 	return &cmd
 }
+
+// listLanguage returns the language of the block, marking
+// the detected ones.
+func listLanguage(block *document.CodeBlock) string {
+	if block.LanguageDetected() {
+		return block.ProbableLanguage() + " (detected)"
+	}
+	return block.Language()
+}
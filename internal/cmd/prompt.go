@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/stateful/runme/internal/document"
+	"github.com/stateful/runme/internal/runner"
+	"github.com/stateful/runme/internal/tui"
+)
+
+// parseSetValues validates values of the --set flag in the KEY=VAL format.
+func parseSetValues(values []string) ([]string, error) {
+	for _, value := range values {
+		if idx := strings.Index(value, "="); idx <= 0 {
+			return nil, errors.Errorf("invalid value %q of --set: expected KEY=VAL", value)
+		}
+	}
+	return values, nil
+}
+
+// resolveVariables makes sure that variables the block depends on
+// have values. Missing ones are prompted for, unless prompts are skipped
+// or stdin is not a terminal, and the answers are stored in the session
+// so that subsequent blocks do not ask again. Placeholders in the block
+// are replaced with the values.
+func resolveVariables(cmd *cobra.Command, block *document.CodeBlock, sess *runner.Session, skipPrompts bool) error {
+	variables := block.Variables()
+	if len(variables) == 0 {
+		return nil
+	}
+
+	interactive := !skipPrompts
+	if f, ok := cmd.InOrStdin().(*os.File); !ok || !isTerminal(f.Fd()) {
+		interactive = false
+	}
+
+	values := make(map[string]string, len(variables))
+
+	for _, variable := range variables {
+		if value, ok := sess.LookupEnv(variable.Name); ok {
+			values[variable.Name] = value
+			continue
+		}
+
+		if !interactive {
+			// Unset variables are not necessarily an error as
+			// the block may handle them. Placeholders, on the other
+			// hand, would make the block fail.
+			if variable.Placeholder != "" {
+				return errors.Errorf("variable %s has a placeholder %s; provide its value with --set %s=VALUE", variable.Name, variable.Placeholder, variable.Name)
+			}
+			continue
+		}
+
+		value, err := promptForValue(cmd, variable)
+		if err != nil {
+			return err
+		}
+
+		values[variable.Name] = value
+		sess.AddEnvs([]string{variable.Name + "=" + value})
+	}
+
+	document.ReplacePlaceholders(block.Lines(), values)
+
+	return nil
+}
+
+func promptForValue(cmd *cobra.Command, variable document.Variable) (string, error) {
+	text := fmt.Sprintf("Enter a value for %s:", variable.Name)
+	if variable.Placeholder != "" {
+		text = fmt.Sprintf("Enter a value for %s %s:", variable.Name, variable.Placeholder)
+	}
+
+	model := tui.NewStandaloneInputModel(text, tui.MinimalKeyMap, tui.DefaultStyles)
+	finalModel, err := newProgram(cmd, model).Run()
+	if err != nil {
+		return "", err
+	}
+	val, ok := finalModel.(tui.StandaloneInputModel).Value()
+	if !ok {
+		return "", errors.New("canceled")
+	}
+	return val, nil
+}
@@ -8,12 +8,15 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"github.com/stateful/runme/internal/runner"
 )
 
 var (
 	fAllowUnknown bool
 	fChdir        string
+	fExecutables  []string
 	fFileName     string
+	fInterpreters string
 )
 
 func Root() *cobra.Command {
@@ -23,7 +26,7 @@ func Root() *cobra.Command {
 		Long:          "Parses commands directly from a README (best-effort) to make them executable under a unique name.",
 		SilenceErrors: true,
 		SilenceUsage:  true,
-		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			if strings.HasPrefix(fChdir, "~") {
 				cmd.PrintErrf("WARNING: --chdir starts with ~ which should be resolved by shell. Try re-running with --chdir %s (note lack of =) if it fails.\n\n", fChdir)
 
@@ -35,6 +38,11 @@ func Root() *cobra.Command {
 					fChdir = filepath.Join(usr.HomeDir, fChdir[2:])
 				}
 			}
+
+			runner.RegisterExecutables(fExecutables...)
+
+			// The default file is optional.
+			return registerInterpreters(fInterpreters, !cmd.Flags().Changed("interpreters"))
 		},
 		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 			return nil, cobra.ShellCompDirectiveNoFileComp
@@ -47,7 +55,9 @@ func Root() *cobra.Command {
 
 	pflags.BoolVar(&fAllowUnknown, "allow-unknown", false, "Display snippets without known executor")
 	pflags.StringVar(&fChdir, "chdir", getCwd(), "Switch to a different working directory before executing the command")
+	pflags.StringSliceVar(&fExecutables, "executables", nil, "Additional languages of code blocks run by a command of the same name, for example lua,php; see --interpreters to use other commands")
 	pflags.StringVar(&fFileName, "filename", "README.md", "Name of the README file")
+	pflags.StringVar(&fInterpreters, "interpreters", filepath.Join(getDefaultConfigHome(), "interpreters.yaml"), "YAML file mapping languages of code blocks to interpreters running them")
 
 	setAPIFlags(pflags)
 
@@ -67,10 +77,17 @@ func Root() *cobra.Command {
 
 	cmd.AddCommand(tuiCmd)
 	cmd.AddCommand(runCmd())
+	cmd.AddCommand(psCmd())
+	cmd.AddCommand(logsCmd())
+	cmd.AddCommand(stopCmd())
+	cmd.AddCommand(sessionCmd())
 	cmd.AddCommand(listCmd())
 	cmd.AddCommand(printCmd())
 	cmd.AddCommand(tasksCmd())
 	cmd.AddCommand(fmtCmd())
+	cmd.AddCommand(convertCmd())
+	cmd.AddCommand(lintCmd())
+	cmd.AddCommand(testCmd())
 	cmd.AddCommand(serverCmd())
 	cmd.AddCommand(shellCmd())
 	cmd.AddCommand(authCmd())
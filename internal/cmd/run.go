@@ -14,36 +14,103 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/stateful/runme/internal/document"
 	"github.com/stateful/runme/internal/runner"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 )
 
 type runCmdOpts struct {
 	DryRun         bool
 	ReplaceScripts []string
+	SkipPrompts    bool
 }
 
 func runCmd() *cobra.Command {
-	opts := runCmdOpts{}
+	var (
+		opts        = runCmdOpts{}
+		setValues   []string
+		sessionName string
+	)
 
 	cmd := cobra.Command{
 		Use:               "run",
 		Aliases:           []string{"exec"},
 		Short:             "Run a selected command",
-		Long:              "Run a selected command identified based on its unique parsed name.",
+		Long:              "Run a selected command identified based on its unique parsed name. Commands listed in its \"requires\" attribute are run first. A pattern like \"Deploy/*\" runs all commands in a section, in the order of the document; a \"/\" in a heading is escaped like in \"CI\\/CD/*\".",
 		Args:              cobra.ExactArgs(1),
 		ValidArgsFunction: validCmdNames,
-		RunE: func(cmd *cobra.Command, args []string) error {
-			blocks, err := getCodeBlocks()
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			blocks, fm, err := getCodeBlocksWithFrontmatter()
 			if err != nil {
 				return err
 			}
 
-			block, err := lookupCodeBlock(blocks, args[0])
+			targets, err := lookupCodeBlocks(blocks, args[0])
 			if err != nil {
 				return err
 			}
 
-			return runBlock(cmd, block, nil, &opts)
+			envs, err := parseSetValues(setValues)
+			if err != nil {
+				return err
+			}
+
+			sess, saveSession, err := openSession(fm, sessionName)
+			if err != nil {
+				return err
+			}
+			sess.AddEnvs(envs)
+
+			// Changes made by commands which succeeded
+			// are kept even if a later one fails.
+			defer func() { err = multierr.Append(err, saveSession()) }()
+
+			// Jobs are stopped when the session ends.
+			jobs := newJobServer(sess)
+			defer func() { _ = jobs.Close() }()
+
+			opts.SkipPrompts = fm.SkipPrompts
+
+			done := make(map[*document.CodeBlock]bool)
+
+			for _, block := range targets {
+				queue, err := blocks.ResolveRequires(block.Name())
+				if err != nil {
+					return err
+				}
+
+				// Prerequisites run in the same session so that
+				// exported environment variables are carried over.
+				// Replace scripts apply only to the selected blocks.
+				for _, item := range queue {
+					if done[item] {
+						continue
+					}
+					done[item] = true
+
+					itemOpts := &runCmdOpts{DryRun: opts.DryRun, SkipPrompts: opts.SkipPrompts}
+					if item == block {
+						itemOpts = &opts
+					}
+
+					if !opts.DryRun {
+						if item != block {
+							printfInfo("runme: running %s required by %s", item.Name(), block.Name())
+						} else if len(targets) > 1 {
+							printfInfo("runme: running %s", item.Name())
+						}
+					}
+
+					if err := runBlock(cmd, item, sess, itemOpts); err != nil {
+						return errors.Wrap(err, blockLocation(item))
+					}
+
+					if err := jobs.Serve(); err != nil {
+						return err
+					}
+				}
+			}
+
+			return waitForJobs(cmd, targets, sess)
 		},
 	}
 
@@ -51,6 +118,8 @@ func runCmd() *cobra.Command {
 
 	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Print the final command without executing.")
 	cmd.Flags().StringArrayVarP(&opts.ReplaceScripts, "replace", "r", nil, "Replace instructions using sed.")
+	cmd.Flags().StringArrayVar(&setValues, "set", nil, "Set a variable used by commands in the KEY=VAL format instead of being prompted for it.")
+	cmd.Flags().StringVar(&sessionName, "session", "", "Name of a session keeping environment variables and the working directory between invocations. See \"runme session\".")
 
 	return &cmd
 }
@@ -69,12 +138,18 @@ func runBlock(
 		return err
 	}
 
-	if id, ok := shellID(); ok && runner.IsShell(block.Language()) {
-		return executeInShell(id, block)
+	if sess == nil {
+		sess = runner.NewSession(os.Environ(), zap.NewNop())
 	}
 
-	if sess == nil {
-		sess = runner.NewSession(nil, zap.NewNop())
+	if !opts.DryRun && runner.IsShell(block.ProbableLanguage()) {
+		if err := resolveVariables(cmd, block, sess, opts.SkipPrompts); err != nil {
+			return err
+		}
+	}
+
+	if id, ok := shellID(); ok && runner.IsShell(block.ProbableLanguage()) {
+		return executeInShell(id, block)
 	}
 
 	executable, err := newExecutable(cmd, block, sess)
@@ -90,44 +165,101 @@ func runBlock(
 		return nil
 	}
 
-	return errors.WithStack(executable.Run(ctx))
+	if err := executable.Run(ctx); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if isBackground(block) {
+		printfInfo("runme: started %s in the background; see runme logs %s", block.Name(), block.Name())
+	}
+	return nil
+}
+
+// isBackground reports whether the block is run as a job
+// of the session, set with the "background" attribute.
+func isBackground(block *document.CodeBlock) bool {
+	background, _ := strconv.ParseBool(block.Attributes()["background"])
+	return background
+}
+
+// waitForJobs keeps the session alive as long as jobs of
+// the selected blocks run. Jobs of required blocks, like
+// a server used by tests, end with the session.
+func waitForJobs(cmd *cobra.Command, targets document.CodeBlocks, sess *runner.Session) error {
+	var running []*runner.Job
+	for _, block := range targets {
+		if job, ok := sess.Job(block.Name()); ok && isBackground(block) && job.Running() {
+			running = append(running, job)
+		}
+	}
+	if len(running) == 0 {
+		return nil
+	}
+
+	printfInfo("runme: waiting for background jobs; stop them with runme stop <name> or Ctrl+C")
+
+	ctx, cancel := ctxWithSigCancel(cmd.Context())
+	defer cancel()
+
+	for _, job := range running {
+		select {
+		case <-job.Done():
+		case <-ctx.Done():
+			return nil
+		}
+	}
+	return nil
 }
 
 func newExecutable(cmd *cobra.Command, block *document.CodeBlock, sess *runner.Session) (runner.Executable, error) {
 	tty, _ := strconv.ParseBool(block.Attributes()["interactive"])
+	background := isBackground(block)
+	// Jobs run without a terminal.
+	if background {
+		tty = false
+	}
+
+	dir := fChdir
+	if sess.Dir != "" {
+		dir = sess.Dir
+	}
+
+	// A directory changed by the block becomes the default one
+	// of the session, unless it is disabled with trackCwd=false.
+	trackCwd, err := strconv.ParseBool(block.Attributes()["trackCwd"])
+	ignoreDirChange := err == nil && !trackCwd
+
+	timeout, err := block.Timeout()
+	if err != nil {
+		return nil, err
+	}
+	retries, err := block.Retries()
+	if err != nil {
+		return nil, err
+	}
+	retryDelay, err := block.RetryDelay()
+	if err != nil {
+		return nil, err
+	}
 
 	cfg := &runner.ExecutableConfig{
-		Name:    block.Name(),
-		Dir:     fChdir,
-		Tty:     tty,
-		Stdin:   cmd.InOrStdin(),
-		Stdout:  cmd.OutOrStdout(),
-		Stderr:  cmd.ErrOrStderr(),
-		Session: sess,
-		Logger:  zap.NewNop(),
-	}
-
-	switch block.Language() {
-	case "bash", "bat", "sh", "shell", "zsh":
-		return &runner.Shell{
-			ExecutableConfig: cfg,
-			Cmds:             block.Lines(),
-		}, nil
-	case "sh-raw":
-		return &runner.ShellRaw{
-			Shell: &runner.Shell{
-				ExecutableConfig: cfg,
-				Cmds:             block.Lines(),
-			},
-		}, nil
-	case "go":
-		return &runner.Go{
-			ExecutableConfig: cfg,
-			Source:           string(block.Content()),
-		}, nil
-	default:
-		return nil, errors.Errorf("unknown executable: %q", block.Language())
+		Name:       block.Name(),
+		Dir:        dir,
+		Tty:        tty,
+		Stdin:      cmd.InOrStdin(),
+		Stdout:     cmd.OutOrStdout(),
+		Stderr:     cmd.ErrOrStderr(),
+		Session:    sess,
+		Logger:     zap.NewNop(),
+		Timeout:    timeout,
+		Retries:    retries,
+		RetryDelay: retryDelay,
+		Background: background,
+
+		IgnoreDirChange: ignoreDirChange,
 	}
+
+	return runner.NewExecutable(block.ProbableLanguage(), cfg, block.Lines(), string(block.Content()))
 }
 
 func ctxWithSigCancel(ctx context.Context) (context.Context, context.CancelFunc) {
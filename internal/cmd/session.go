@@ -0,0 +1,264 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/stateful/runme/internal/document"
+	"github.com/stateful/runme/internal/runner"
+)
+
+// sessionState is what a named session keeps between invocations:
+// changes of environment variables and of the working directory.
+// Variables inherited from the environment of runme are not stored.
+type sessionState struct {
+	Envs      []string  `json:"envs,omitempty"`
+	Unset     []string  `json:"unset,omitempty"`
+	Dir       string    `json:"dir,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+var sessionNameRe = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+func sessionsDir() string {
+	return filepath.Join(getDefaultConfigHome(), "sessions")
+}
+
+func sessionPath(name string) (string, error) {
+	if !sessionNameRe.MatchString(name) {
+		return "", errors.Errorf("invalid session name %q: use letters, digits, dots, dashes and underscores", name)
+	}
+	return filepath.Join(sessionsDir(), name+".json"), nil
+}
+
+// loadSessionState returns the state of the named session
+// and whether it exists.
+func loadSessionState(name string) (*sessionState, bool, error) {
+	path, err := sessionPath(name)
+	if err != nil {
+		return nil, false, err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &sessionState{}, false, nil
+	} else if err != nil {
+		return nil, false, errors.Wrapf(err, "failed to read session %q", name)
+	}
+
+	var state sessionState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, false, errors.Wrapf(err, "invalid session %q", name)
+	}
+	return &state, true, nil
+}
+
+func saveSessionState(name string, state *sessionState) error {
+	path, err := sessionPath(name)
+	if err != nil {
+		return err
+	}
+
+	// Values of variables might be secrets.
+	if err := os.MkdirAll(sessionsDir(), 0o700); err != nil {
+		return errors.Wrap(err, "failed to create sessions dir")
+	}
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// Write to a temp file first so that the session
+	// is not corrupted if runme is interrupted.
+	f, err := os.CreateTemp(sessionsDir(), name+".*.tmp")
+	if err != nil {
+		return errors.Wrapf(err, "failed to save session %q", name)
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to save session %q", name)
+	}
+
+	return errors.Wrapf(os.Rename(f.Name(), path), "failed to save session %q", name)
+}
+
+// openSession creates a session like newSession. If name is not empty,
+// the session is restored from the state saved by previous invocations,
+// and the returned function saves its state again.
+func openSession(fm *document.Frontmatter, name string) (*runner.Session, func() error, error) {
+	sess, err := newSession(fm)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if name == "" {
+		return sess, func() error { return nil }, nil
+	}
+
+	state, _, err := loadSessionState(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defaultDir := sess.Dir
+
+	sess.AddEnvs(state.Envs)
+	sess.DeleteEnvs(state.Unset...)
+	if state.Dir != "" {
+		sess.Dir = state.Dir
+	}
+
+	save := func() error {
+		state.Envs, state.Unset = sess.EnvChanges()
+		// The directory is stored only if it was changed so that
+		// otherwise it follows --chdir and the document.
+		if sess.Dir != defaultDir {
+			state.Dir = sess.Dir
+		} else {
+			state.Dir = ""
+		}
+		state.UpdatedAt = time.Now()
+		return saveSessionState(name, state)
+	}
+
+	return sess, save, nil
+}
+
+func sessionCmd() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "session",
+		Short: "Manage named sessions",
+		Long:  "Manage named sessions, which keep environment variables and the working directory between invocations of runme, for example, runme run --session dev. Values of variables are stored unencrypted in the config directory.",
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(sessionListCmd())
+	cmd.AddCommand(sessionShowCmd())
+	cmd.AddCommand(sessionResetCmd())
+
+	return &cmd
+}
+
+func sessionListCmd() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List named sessions",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			paths, err := filepath.Glob(filepath.Join(sessionsDir(), "*.json"))
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			io := iostreams.System()
+			//lint:ignore SA1019 utils is deprecated but that's ok for now.
+			table := utils.NewTablePrinter(io)
+
+			table.AddField(strings.ToUpper("Name"), nil, nil)
+			table.AddField(strings.ToUpper("Variables"), nil, nil)
+			table.AddField(strings.ToUpper("Dir"), nil, nil)
+			table.AddField(strings.ToUpper("Updated"), nil, nil)
+			table.EndRow()
+
+			for _, path := range paths {
+				name := strings.TrimSuffix(filepath.Base(path), ".json")
+
+				state, _, err := loadSessionState(name)
+				if err != nil {
+					return err
+				}
+
+				table.AddField(name, nil, nil)
+				table.AddField(strconv.Itoa(len(state.Envs)+len(state.Unset)), nil, nil)
+				table.AddField(state.Dir, nil, nil)
+				table.AddField(state.UpdatedAt.Format(time.Stamp), nil, nil)
+				table.EndRow()
+			}
+
+			return errors.Wrap(table.Render(), "failed to render")
+		},
+	}
+
+	setDefaultFlags(&cmd)
+
+	return &cmd
+}
+
+func sessionShowCmd() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "show <name>",
+		Short: "Show changes kept by a named session",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			state, ok, err := loadSessionState(args[0])
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return errors.Errorf("session %q does not exist", args[0])
+			}
+
+			var b strings.Builder
+			if state.Dir != "" {
+				_, _ = fmt.Fprintf(&b, "cd %s\n", state.Dir)
+			}
+			for _, env := range state.Envs {
+				_, _ = fmt.Fprintf(&b, "%s\n", env)
+			}
+			for _, name := range state.Unset {
+				_, _ = fmt.Fprintf(&b, "unset %s\n", name)
+			}
+
+			_, err = cmd.OutOrStdout().Write([]byte(b.String()))
+			return errors.WithStack(err)
+		},
+	}
+
+	setDefaultFlags(&cmd)
+
+	return &cmd
+}
+
+func sessionResetCmd() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "reset <name>",
+		Short: "Forget changes kept by a named session",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, err := sessionPath(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err := os.Remove(path); os.IsNotExist(err) {
+				return errors.Errorf("session %q does not exist", args[0])
+			} else if err != nil {
+				return errors.Wrapf(err, "failed to reset session %q", args[0])
+			}
+
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "reset session %s\n", args[0])
+			return errors.WithStack(err)
+		},
+	}
+
+	setDefaultFlags(&cmd)
+
+	return &cmd
+}
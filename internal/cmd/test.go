@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+	"github.com/stateful/runme/internal/document"
+	"github.com/stateful/runme/internal/runner"
+	"go.uber.org/zap"
+)
+
+func testCmd() *cobra.Command {
+	cmd := cobra.Command{
+		Use:               "test [names...]",
+		Short:             "Test documented output of console blocks",
+		Long:              "Test runs commands of console code blocks, i.e. lines with a \"$ \" prompt, and compares their output with the documented one. All console blocks are tested unless names or patterns are provided.",
+		ValidArgsFunction: validCmdNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			blocks, fm, err := getCodeBlocksWithFrontmatter()
+			if err != nil {
+				return err
+			}
+
+			var selected document.CodeBlocks
+			if len(args) == 0 {
+				selected = blocks
+			}
+			for _, arg := range args {
+				result, err := lookupCodeBlocks(blocks, arg)
+				if err != nil {
+					return err
+				}
+				selected = append(selected, result...)
+			}
+
+			var consoleBlocks document.CodeBlocks
+			for _, block := range selected {
+				if document.IsConsole(block.ProbableLanguage()) {
+					consoleBlocks = append(consoleBlocks, block)
+				}
+			}
+			if len(consoleBlocks) == 0 {
+				return errors.Errorf("no console code blocks in %s", fFileName)
+			}
+
+			sess, err := newSession(fm)
+			if err != nil {
+				return err
+			}
+
+			failed := 0
+			for _, block := range consoleBlocks {
+				ok, err := testBlock(cmd, block, sess)
+				if err != nil {
+					return errors.Wrap(err, blockLocation(block))
+				}
+				if !ok {
+					failed++
+				}
+			}
+
+			if failed > 0 {
+				return errors.Errorf("%d of %d console blocks failed", failed, len(consoleBlocks))
+			}
+			return nil
+		},
+	}
+
+	setDefaultFlags(&cmd)
+
+	return &cmd
+}
+
+// testBlock runs commands of the console block one by one in the session
+// and reports whether their output matches the documented one. A command
+// exiting with an error passes as long as its output matches.
+func testBlock(cmd *cobra.Command, block *document.CodeBlock, sess *runner.Session) (bool, error) {
+	dir := fChdir
+	if sess.Dir != "" {
+		dir = sess.Dir
+	}
+
+	w := bulkWriter{Writer: cmd.OutOrStdout()}
+	passed := true
+
+	for _, command := range block.ConsoleCommands() {
+		var out bytes.Buffer
+
+		// Commands are run verbatim, like they would be typed in a terminal.
+		executable := &runner.ShellRaw{
+			Shell: &runner.Shell{
+				ExecutableConfig: &runner.ExecutableConfig{
+					Name:    block.Name(),
+					Dir:     dir,
+					Stdin:   bytes.NewReader(nil),
+					Stdout:  &out,
+					Stderr:  &out,
+					Session: sess,
+					Logger:  zap.NewNop(),
+				},
+				Cmds: command.Lines,
+			},
+		}
+
+		ctx, cancel := ctxWithSigCancel(cmd.Context())
+		runErr := executable.Run(ctx)
+		cancel()
+
+		expected, actual := normalizeOutput(command.Output), normalizeOutput(out.String())
+		if expected == actual {
+			continue
+		}
+
+		if passed {
+			w.Write([]byte(fmt.Sprintf("--- FAIL: %s (%s)\n", block.Name(), blockLocation(block))))
+			passed = false
+		}
+
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(expected + "\n"),
+			B:        difflib.SplitLines(actual + "\n"),
+			FromFile: "expected",
+			ToFile:   "actual",
+			Context:  3,
+		})
+		if err != nil {
+			return false, errors.Wrap(err, "failed to diff output")
+		}
+
+		w.Write([]byte("$ " + strings.Join(command.Lines, "\n> ") + "\n"))
+		if runErr != nil {
+			w.Write([]byte(runErr.Error() + "\n"))
+		}
+		w.Write([]byte(diff))
+	}
+
+	if passed {
+		w.Write([]byte(fmt.Sprintf("ok %s\n", block.Name())))
+	}
+
+	return passed, errors.Wrap(w.Err(), "failed to write out result")
+}
+
+// normalizeOutput removes differences in output which are not meaningful:
+// line endings, trailing whitespace, and leading and trailing blank lines.
+func normalizeOutput(s string) string {
+	lines := strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+	for idx, line := range lines {
+		lines[idx] = strings.TrimRight(line, " \t")
+	}
+	return strings.Trim(strings.Join(lines, "\n"), "\n")
+}
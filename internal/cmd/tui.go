@@ -11,9 +11,8 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/stateful/runme/internal/document"
 	rmath "github.com/stateful/runme/internal/math"
-	"github.com/stateful/runme/internal/runner"
 	"github.com/stateful/runme/internal/version"
-	"go.uber.org/zap"
+	"go.uber.org/multierr"
 )
 
 type tuiModel struct {
@@ -74,6 +73,11 @@ func (m tuiModel) View() string {
 	for i := m.scroll; i < m.scroll+m.numBlocksShown(); i++ {
 		block := m.blocks[i]
 
+		// Group entries under headings of their sections.
+		if section := block.Section(); section != "" && (i == m.scroll || section != m.blocks[i-1].Section()) {
+			_, _ = s.WriteString(ansi.Color("# "+strings.Join(block.Sections(), " › "), "57") + "\n")
+		}
+
 		active := i == m.cursor
 		_, expanded := m.expanded[i]
 
@@ -86,7 +90,7 @@ func (m tuiModel) View() string {
 
 		{
 			name := block.Name()
-			lang := ansi.Color(block.Language(), "white+d")
+			lang := ansi.Color(block.ProbableLanguage(), "white+d")
 
 			if active {
 				name = ansi.Color(name, "white+b")
@@ -188,14 +192,16 @@ func tuiCmd() *cobra.Command {
 	var (
 		visibleEntries int
 		runOnce        bool
+		setValues      []string
+		sessionName    string
 	)
 
 	cmd := cobra.Command{
 		Use:   "tui",
 		Short: "Run the interactive TUI",
 		Long:  "Run a command from a descriptive list given by an interactive TUI.",
-		RunE: func(cmd *cobra.Command, args []string) error {
-			blocks, err := getCodeBlocks()
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			blocks, fm, err := getCodeBlocksWithFrontmatter()
 			if err != nil {
 				return err
 			}
@@ -208,7 +214,22 @@ func tuiCmd() *cobra.Command {
 				visibleEntries = math.MaxInt32
 			}
 
-			sess := runner.NewSession(nil, zap.NewNop())
+			envs, err := parseSetValues(setValues)
+			if err != nil {
+				return err
+			}
+
+			sess, saveSession, err := openSession(fm, sessionName)
+			if err != nil {
+				return err
+			}
+			sess.AddEnvs(envs)
+
+			defer func() { err = multierr.Append(err, saveSession()) }()
+
+			// Jobs are stopped when the session ends.
+			jobs := newJobServer(sess)
+			defer func() { _ = jobs.Close() }()
 
 			model := tuiModel{
 				blocks: blocks,
@@ -236,12 +257,16 @@ func tuiCmd() *cobra.Command {
 					break
 				}
 
-				if err := runBlock(cmd, result.block, sess, nil); err != nil {
+				if err := runBlock(cmd, result.block, sess, &runCmdOpts{SkipPrompts: fm.SkipPrompts}); err != nil {
 					if _, err := fmt.Printf(ansi.Color("%v", "red")+"\n", err); err != nil {
 						return err
 					}
 				}
 
+				if err := jobs.Serve(); err != nil {
+					return err
+				}
+
 				if runOnce || result.exit {
 					break
 				}
@@ -261,6 +286,8 @@ func tuiCmd() *cobra.Command {
 
 	cmd.Flags().BoolVar(&runOnce, "exit", false, "Exit TUI after running a command")
 	cmd.Flags().IntVar(&visibleEntries, "entries", defaultVisibleEntries, "Number of entries to show in TUI")
+	cmd.Flags().StringArrayVar(&setValues, "set", nil, "Set a variable used by commands in the KEY=VAL format instead of being prompted for it.")
+	cmd.Flags().StringVar(&sessionName, "session", "", "Name of a session keeping environment variables and the working directory between invocations. See \"runme session\".")
 
 	return &cmd
 }
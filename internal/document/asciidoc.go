@@ -0,0 +1,228 @@
+package document
+
+import (
+	"regexp"
+	"strings"
+)
+
+// AsciiDoc is the Format of AsciiDoc documents. Code blocks are
+// listing blocks, optionally with the source style, for example:
+//
+//	[source,bash,name=hello]
+//	----
+//	echo hello
+//	----
+//
+// The ID of a block, like in "[[hello]]" or "[source#hello,bash]",
+// names it unless the name attribute is set. Literal blocks are code
+// blocks only with the source style, whereas Markdown-style fences
+// always are. The language of blocks without one defaults to
+// the source-language document attribute.
+var AsciiDoc Format = asciiDoc{}
+
+type asciiDoc struct{}
+
+var (
+	asciiDocHeadingRe   = regexp.MustCompile(`^(=+)\s+(.*\S)\s*$`)
+	asciiDocAnchorRe    = regexp.MustCompile(`^\[\[([^,\]]+)(?:,[^\]]*)?\]\]$`)
+	asciiDocAttrListRe  = regexp.MustCompile(`^\[([^\[\]]*)\]$`)
+	asciiDocDocAttrRe   = regexp.MustCompile(`^:([\w-]+):\s*(.*)$`)
+	asciiDocTitleRe     = regexp.MustCompile(`^\.([^.\s].*)$`)
+	asciiDocDelimiterRe = regexp.MustCompile(`^(-{4,}|\.{4,}|/{4,}|\+{4,})$`)
+	asciiDocFenceRe     = regexp.MustCompile("^```\\s*([^\\s,]*)")
+)
+
+func (asciiDoc) CodeBlocks(source []byte) (CodeBlocks, error) {
+	var (
+		b     = newLineBlocks(source)
+		lines = splitLines(source)
+		// Block attributes, anchors, and titles apply to the block
+		// following them.
+		attrs     *asciiDocAttributes
+		attrsLine = -1
+		title     string
+		// defaultLanguage is the value of the source-language attribute.
+		defaultLanguage string
+	)
+
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimRight(lines[i], " \t")
+
+		if m := asciiDocDelimiterRe.FindStringSubmatch(line); m != nil {
+			end := findLine(lines, i+1, func(l string) bool { return strings.TrimRight(l, " \t") == line })
+			isCode := line[0] == '-' || (line[0] == '.' && attrs != nil && attrs.source)
+			if isCode {
+				start := i
+				if attrsLine != -1 {
+					start = attrsLine
+				}
+				language := defaultLanguage
+				attributes := map[string]string{}
+				if attrs != nil {
+					if attrs.language != "" {
+						language = attrs.language
+					}
+					attributes = attrs.named
+				}
+				if title != "" {
+					b.title(title)
+				}
+				b.add(b.offset(start), b.lineEnd(end), language, attributes, lines[i+1:end])
+			} else {
+				// Comments and passthrough blocks are not prose.
+				b.text("")
+			}
+			attrs, attrsLine, title = nil, -1, ""
+			i = end
+			continue
+		}
+
+		if m := asciiDocFenceRe.FindStringSubmatch(line); m != nil {
+			end := findLine(lines, i+1, func(l string) bool { return strings.TrimSpace(l) == "```" })
+			if title != "" {
+				b.title(title)
+			}
+			language := m[1]
+			if language == "" {
+				language = defaultLanguage
+			}
+			b.add(b.offset(i), b.lineEnd(end), language, map[string]string{}, lines[i+1:end])
+			attrs, attrsLine, title = nil, -1, ""
+			i = end
+			continue
+		}
+
+		if m := asciiDocAnchorRe.FindStringSubmatch(line); m != nil {
+			if attrs == nil {
+				attrs = &asciiDocAttributes{named: map[string]string{}}
+				attrsLine = i
+			}
+			if attrs.named["name"] == "" {
+				attrs.named["name"] = m[1]
+			}
+			continue
+		}
+
+		if m := asciiDocAttrListRe.FindStringSubmatch(line); m != nil {
+			parsed := parseAsciiDocAttributes(m[1])
+			if attrs != nil {
+				// An anchor preceding the attribute list names the block.
+				if name := attrs.named["name"]; name != "" && parsed.named["name"] == "" {
+					parsed.named["name"] = name
+				}
+			} else {
+				attrsLine = i
+			}
+			attrs = parsed
+			continue
+		}
+
+		if m := asciiDocTitleRe.FindStringSubmatch(line); m != nil {
+			title = m[1]
+			continue
+		}
+
+		attrs, attrsLine, title = nil, -1, ""
+
+		switch {
+		case strings.HasPrefix(line, "//"):
+			// Line comments are skipped.
+		case asciiDocHeadingRe.MatchString(line):
+			m := asciiDocHeadingRe.FindStringSubmatch(line)
+			b.heading(len(m[1]), m[2])
+		case asciiDocDocAttrRe.MatchString(line):
+			m := asciiDocDocAttrRe.FindStringSubmatch(line)
+			if m[1] == "source-language" {
+				defaultLanguage = m[2]
+			}
+			b.text("")
+		default:
+			b.text(line)
+		}
+	}
+
+	return b.result, nil
+}
+
+// asciiDocAttributes are the attributes of a block
+// from its attribute list, for example, "[source#hello,bash]".
+type asciiDocAttributes struct {
+	source   bool
+	language string
+	named    map[string]string
+}
+
+// parseAsciiDocAttributes parses the content of an attribute list.
+// The first positional attribute is the style, optionally followed by
+// an ID, roles, and options, and the second one is the language.
+// The style can be omitted like in "[,bash]".
+func parseAsciiDocAttributes(s string) *asciiDocAttributes {
+	result := &asciiDocAttributes{named: map[string]string{}}
+
+	for i, item := range splitAsciiDocAttributes(s) {
+		if key, value, ok := strings.Cut(item, "="); ok {
+			result.named[strings.TrimSpace(key)] = unquote(strings.TrimSpace(value))
+			continue
+		}
+
+		item = unquote(strings.TrimSpace(item))
+
+		switch i {
+		case 0:
+			style := item
+			if idx := strings.IndexAny(style, "#.%"); idx != -1 {
+				shorthands := style[idx:]
+				if idx := strings.IndexByte(shorthands, '#'); idx != -1 {
+					id := shorthands[idx+1:]
+					if end := strings.IndexAny(id, ".%"); end != -1 {
+						id = id[:end]
+					}
+					result.named["name"] = id
+				}
+				style = style[:idx]
+			}
+			result.source = style == "source" || style == ""
+		case 1:
+			if result.source {
+				result.language = item
+			}
+		}
+	}
+
+	return result
+}
+
+// splitAsciiDocAttributes splits the attribute list by commas
+// which are not in quotes.
+func splitAsciiDocAttributes(s string) (result []string) {
+	var (
+		quote rune
+		start int
+	)
+	for i, c := range s {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == ',':
+			result = append(result, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(result, s[start:])
+}
+
+// findLine returns the index of the first line starting from
+// the index start which satisfies fn or len(lines) if there is none.
+// Hence, unclosed blocks end with the document.
+func findLine(lines []string, start int, fn func(string) bool) int {
+	for i := start; i < len(lines); i++ {
+		if fn(lines[i]) {
+			return i
+		}
+	}
+	return len(lines)
+}
@@ -0,0 +1,108 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsciiDoc_CodeBlocks(t *testing.T) {
+	source := []byte(`= Handbook
+:source-language: sh
+
+== Setup
+
+Install dependencies.
+
+[source,bash,name=install]
+----
+$ npm install
+----
+
+[[build]]
+[source,bash]
+----
+npm run build
+----
+
+.Run the tests
+[source#test,sh,interactive=false]
+----
+npm test
+----
+
+// A comment.
+
+////
+[source,bash]
+----
+echo commented
+----
+////
+
+=== Checks
+
+----
+npm run lint
+----
+
+[source,python]
+....
+print(1)
+....
+
+....
+not code
+....
+
+` + "```js\nconsole.log(1)\n```\n")
+
+	blocks, err := AsciiDoc.CodeBlocks(source)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"install", "build", "test", "npm-run", "print1", "consolelog1"}, blocks.Names())
+
+	install := blocks[0]
+	assert.Equal(t, "bash", install.Language())
+	assert.Equal(t, []string{"npm install"}, install.Lines())
+	assert.Equal(t, "$ npm install", string(install.Content()))
+	assert.Equal(t, "Install dependencies.", install.Intro())
+	assert.Equal(t, []string{"Handbook", "Setup"}, install.Sections())
+	assert.Equal(t, 8, install.Range().Start.Line)
+	assert.Equal(t, 11, install.Range().End.Line)
+	assert.Equal(t, "[source,bash,name=install]\n----\n$ npm install\n----", string(install.Value()))
+	assert.Nil(t, install.Unwrap())
+
+	assert.Equal(t, 13, blocks.Lookup("build").Range().Start.Line)
+
+	test := blocks.Lookup("test")
+	assert.Equal(t, "sh", test.Language())
+	assert.Equal(t, "Run the tests", test.Intro())
+	assert.Equal(t, map[string]string{"name": "test", "interactive": "false"}, test.Attributes())
+
+	lint := blocks[3]
+	assert.Equal(t, "sh", lint.Language())
+	assert.Equal(t, "Handbook/Setup/Checks", lint.Section())
+
+	assert.Equal(t, "python", blocks[4].Language())
+	assert.Equal(t, "js", blocks[5].Language())
+}
+
+func Test_parseAsciiDocAttributes(t *testing.T) {
+	testCases := []struct {
+		source   string
+		expected *asciiDocAttributes
+	}{
+		{"source,bash", &asciiDocAttributes{source: true, language: "bash", named: map[string]string{}}},
+		{",bash", &asciiDocAttributes{source: true, language: "bash", named: map[string]string{}}},
+		{"source.role#hello%linenums,bash", &asciiDocAttributes{source: true, language: "bash", named: map[string]string{"name": "hello"}}},
+		{`source,sh,name="a b",x='1,2'`, &asciiDocAttributes{source: true, language: "sh", named: map[string]string{"name": "a b", "x": "1,2"}}},
+		{"quote,Author", &asciiDocAttributes{named: map[string]string{}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.source, func(t *testing.T) {
+			assert.Equal(t, tc.expected, parseAsciiDocAttributes(tc.source))
+		})
+	}
+}
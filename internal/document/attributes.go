@@ -0,0 +1,306 @@
+package document
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// rawAttributes returns the content between the outermost curly braces
+// in the info string of a fenced code block.
+func rawAttributes(source []byte) []byte {
+	start, stop := rawAttributesBounds(source)
+	if start == -1 {
+		return nil
+	}
+	return bytes.TrimSpace(source[start+1 : stop])
+}
+
+// rawAttributesBounds returns positions of the outermost curly braces
+// in the info string of a fenced code block or -1 if there are none.
+func rawAttributesBounds(source []byte) (start, stop int) {
+	start = bytes.IndexByte(source, '{')
+	if start == -1 {
+		return -1, -1
+	}
+
+	var (
+		depth int
+		quote byte
+	)
+
+	for i := start; i < len(source); i++ {
+		c := source[i]
+
+		if quote != 0 {
+			switch c {
+			case '\\':
+				i++
+			case quote:
+				quote = 0
+			}
+			continue
+		}
+
+		switch c {
+		case '"', '\'':
+			// Quotes start strings only at the beginning of values,
+			// or in JSON values. Elsewhere, like in "don't",
+			// they are regular characters.
+			if source[i-1] == '=' || (depth > 1 && c == '"') {
+				quote = c
+			}
+		case '{', '[':
+			depth++
+		case '}', ']':
+			depth--
+			if depth == 0 {
+				return start, i
+			}
+		}
+	}
+
+	return -1, -1
+}
+
+// ParseAttributes parses attributes in the info string of a fenced
+// code block. Unlike CodeBlock.Attributes, which ignores malformed
+// attributes, it reports an error, including for unterminated braces.
+func ParseAttributes(info []byte) (map[string]string, error) {
+	if bytes.IndexByte(info, '{') == -1 {
+		return map[string]string{}, nil
+	}
+	start, stop := rawAttributesBounds(info)
+	if start == -1 {
+		return map[string]string{}, errors.New("unterminated attributes")
+	}
+	return parseRawAttributes(bytes.TrimSpace(info[start+1 : stop]))
+}
+
+// parseRawAttributes parses attributes of a fenced code block, for example:
+//
+//	name=deploy description="push to prod" interactive args=["-v", "--force"]
+//
+// Attributes are separated by whitespace. A key without a value is
+// a boolean flag set to "true". A value can be bare (ends at whitespace),
+// quoted with double or single quotes, or a JSON object or array.
+// Quoted values support \n, \r, \t and escaped quotes and backslashes;
+// other escapes, like "\d", are kept as they are. A repeated key results
+// in a JSON array of all its values.
+func parseRawAttributes(source []byte) (map[string]string, error) {
+	attributes, _, err := parseRawAttributesRepeated(source)
+	return attributes, err
+}
+
+// parseRawAttributesRepeated is like parseRawAttributes but also returns
+// keys which were repeated so that they can be written back in their
+// original form, for example, "tag=a tag=b" instead of "tag=["a","b"]".
+func parseRawAttributesRepeated(source []byte) (map[string]string, []string, error) {
+	p := attributesParser{source: source}
+	attributes, err := p.parse()
+	return attributes, p.repeated, err
+}
+
+type attributesParser struct {
+	source   []byte
+	pos      int
+	repeated []string
+}
+
+func (p *attributesParser) parse() (map[string]string, error) {
+	var (
+		keys   []string
+		values = make(map[string][]string)
+	)
+
+	for {
+		p.skipSpace()
+		if p.eof() {
+			break
+		}
+
+		key := p.parseKey()
+		if key == "" {
+			return p.buildAttributes(keys, values), errors.Errorf("invalid character %q at position %d", p.source[p.pos], p.pos)
+		}
+
+		value := "true"
+
+		if !p.eof() && p.source[p.pos] == '=' {
+			p.pos++
+
+			var err error
+			value, err = p.parseValue()
+			if err != nil {
+				return p.buildAttributes(keys, values), errors.Wrapf(err, "invalid value of %q", key)
+			}
+		}
+
+		if _, ok := values[key]; !ok {
+			keys = append(keys, key)
+		}
+		values[key] = append(values[key], value)
+	}
+
+	return p.buildAttributes(keys, values), nil
+}
+
+func (p *attributesParser) buildAttributes(keys []string, values map[string][]string) map[string]string {
+	result := make(map[string]string, len(keys))
+	for _, k := range keys {
+		v := values[k]
+		if len(v) == 1 {
+			result[k] = v[0]
+			continue
+		}
+		data, _ := json.Marshal(v)
+		result[k] = string(data)
+		p.repeated = append(p.repeated, k)
+	}
+	return result
+}
+
+func (p *attributesParser) eof() bool {
+	return p.pos >= len(p.source)
+}
+
+func (p *attributesParser) skipSpace() {
+	for !p.eof() && isAttributeSpace(p.source[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *attributesParser) parseKey() string {
+	start := p.pos
+	for !p.eof() {
+		c := p.source[p.pos]
+		if isAttributeSpace(c) || c == '=' || c == '"' || c == '\'' || c == '{' || c == '}' || c == '[' || c == ']' {
+			break
+		}
+		p.pos++
+	}
+	return string(p.source[start:p.pos])
+}
+
+func (p *attributesParser) parseValue() (string, error) {
+	if p.eof() {
+		return "", nil
+	}
+
+	switch c := p.source[p.pos]; c {
+	case '"', '\'':
+		return p.parseQuoted(c)
+	case '{', '[':
+		return p.parseJSON()
+	default:
+		start := p.pos
+		for !p.eof() && !isAttributeSpace(p.source[p.pos]) {
+			p.pos++
+		}
+		return string(p.source[start:p.pos]), nil
+	}
+}
+
+func (p *attributesParser) parseQuoted(quote byte) (string, error) {
+	var b strings.Builder
+
+	// Skip the opening quote.
+	p.pos++
+
+	for !p.eof() {
+		c := p.source[p.pos]
+		p.pos++
+
+		switch c {
+		case quote:
+			return b.String(), nil
+		case '\\':
+			if p.eof() {
+				return "", errors.New("unterminated escape sequence")
+			}
+			writeUnescapedAttributeByte(&b, p.source[p.pos])
+			p.pos++
+		default:
+			_ = b.WriteByte(c)
+		}
+	}
+
+	return "", errors.New("unterminated quoted string")
+}
+
+// writeUnescapedAttributeByte writes the character escaped with
+// a backslash. Unknown escapes, like in regular expressions "\d"
+// or Windows paths "C:\Users", keep the backslash.
+func writeUnescapedAttributeByte(b *strings.Builder, c byte) {
+	switch c {
+	case 'n':
+		_ = b.WriteByte('\n')
+	case 'r':
+		_ = b.WriteByte('\r')
+	case 't':
+		_ = b.WriteByte('\t')
+	case '"', '\'', '\\':
+		_ = b.WriteByte(c)
+	default:
+		_ = b.WriteByte('\\')
+		_ = b.WriteByte(c)
+	}
+}
+
+func (p *attributesParser) parseJSON() (string, error) {
+	dec := json.NewDecoder(bytes.NewReader(p.source[p.pos:]))
+	var raw json.RawMessage
+	if err := dec.Decode(&raw); err != nil {
+		return "", errors.Wrap(err, "invalid JSON")
+	}
+	p.pos += int(dec.InputOffset())
+	return string(raw), nil
+}
+
+func isAttributeSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+// FormatAttributeValue returns a representation of an attribute value
+// that parses back to the same value. Bare values and single-line
+// JSON objects and arrays are returned as they are; other values
+// are quoted.
+func FormatAttributeValue(value string) string {
+	if value == "" {
+		return value
+	}
+
+	if (value[0] == '{' || value[0] == '[') && json.Valid([]byte(value)) && !strings.ContainsAny(value, "\r\n") {
+		return value
+	}
+
+	if strings.ContainsAny(value, " \t\r\n\"'\\{}[]") {
+		return quoteAttributeValue(value)
+	}
+
+	return value
+}
+
+func quoteAttributeValue(value string) string {
+	var b strings.Builder
+	_ = b.WriteByte('"')
+	for i := 0; i < len(value); i++ {
+		switch c := value[i]; c {
+		case '"', '\\':
+			_ = b.WriteByte('\\')
+			_ = b.WriteByte(c)
+		case '\n':
+			_, _ = b.WriteString(`\n`)
+		case '\r':
+			_, _ = b.WriteString(`\r`)
+		case '\t':
+			_, _ = b.WriteString(`\t`)
+		default:
+			_ = b.WriteByte(c)
+		}
+	}
+	_ = b.WriteByte('"')
+	return b.String()
+}
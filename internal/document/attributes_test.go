@@ -0,0 +1,154 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_rawAttributes(t *testing.T) {
+	testCases := []struct {
+		name     string
+		data     string
+		expected string
+	}{
+		{"None", "sh", ""},
+		{"Empty", "sh {}", ""},
+		{"Simple", "sh { name=echo }", "name=echo"},
+		{"NestedJSON", `sh { env={"A":{"B":"}"}} name=x }`, `env={"A":{"B":"}"}} name=x`},
+		{"QuotedBrace", `sh { description="a } b" }`, `description="a } b"`},
+		{"Unterminated", "sh { name=echo", ""},
+		{"Apostrophe", "sh { name=foo description=don't }", "name=foo description=don't"},
+		{"QuoteInValue", `sh { name=foo title=5"-screen }`, `name=foo title=5"-screen`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, string(rawAttributes([]byte(tc.data))))
+		})
+	}
+}
+
+func Test_parseRawAttributes(t *testing.T) {
+	testCases := []struct {
+		name     string
+		data     string
+		expected map[string]string
+	}{
+		{
+			name:     "Bare",
+			data:     "name=echo first= second=2",
+			expected: map[string]string{"name": "echo", "first": "", "second": "2"},
+		},
+		{
+			name:     "ValueWithEquals",
+			data:     "url=https://example.com/?a=b&c=d",
+			expected: map[string]string{"url": "https://example.com/?a=b&c=d"},
+		},
+		{
+			name:     "Quoted",
+			data:     `name=deploy description="push to prod" other='single "quoted"'`,
+			expected: map[string]string{"name": "deploy", "description": "push to prod", "other": `single "quoted"`},
+		},
+		{
+			name:     "Escapes",
+			data:     `value="a \"b\" \\ c\td\n"`,
+			expected: map[string]string{"value": "a \"b\" \\ c\td\n"},
+		},
+		{
+			name:     "UnknownEscapes",
+			data:     `pattern="^\d+$" path='C:\Users\Public'`,
+			expected: map[string]string{"pattern": `^\d+$`, "path": `C:\Users\Public`},
+		},
+		{
+			name:     "Boolean",
+			data:     "interactive name=echo readonly",
+			expected: map[string]string{"interactive": "true", "name": "echo", "readonly": "true"},
+		},
+		{
+			name:     "JSON",
+			data:     `args=["-v", "--force"] env={"A": "1 2"}`,
+			expected: map[string]string{"args": `["-v", "--force"]`, "env": `{"A": "1 2"}`},
+		},
+		{
+			name:     "Apostrophe",
+			data:     "name=foo description=don't",
+			expected: map[string]string{"name": "foo", "description": "don't"},
+		},
+		{
+			name:     "RepeatedKeys",
+			data:     "tag=a name=echo tag=b tag=\"c d\"",
+			expected: map[string]string{"tag": `["a","b","c d"]`, "name": "echo"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := parseRawAttributes([]byte(tc.data))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+
+	t.Run("RepeatedKeys", func(t *testing.T) {
+		_, repeated, err := parseRawAttributesRepeated([]byte("tag=a name=echo tag=b"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"tag"}, repeated)
+	})
+
+	t.Run("UnterminatedQuote", func(t *testing.T) {
+		result, err := parseRawAttributes([]byte(`name=echo description="push`))
+		assert.Error(t, err)
+		assert.Equal(t, map[string]string{"name": "echo"}, result)
+	})
+
+	t.Run("InvalidJSON", func(t *testing.T) {
+		_, err := parseRawAttributes([]byte(`args=["a",`))
+		assert.Error(t, err)
+	})
+}
+
+func TestParseAttributes(t *testing.T) {
+	result, err := ParseAttributes([]byte("sh { name=echo }"))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"name": "echo"}, result)
+
+	result, err = ParseAttributes([]byte("sh"))
+	assert.NoError(t, err)
+	assert.Empty(t, result)
+
+	_, err = ParseAttributes([]byte("sh { name=echo"))
+	assert.EqualError(t, err, "unterminated attributes")
+
+	_, err = ParseAttributes([]byte(`sh { name="echo }`))
+	assert.Error(t, err)
+}
+
+func TestFormatAttributeValue(t *testing.T) {
+	values := []string{
+		"",
+		"echo",
+		"true",
+		"https://example.com/?a=b",
+		"push to prod",
+		`single "quoted"`,
+		"a \"b\" \\ c\td\n",
+		`^\d+$`,
+		`["-v", "--force"]`,
+		`{"A": "1 2"}`,
+		"[not json",
+		"{\n  \"a\": 1\n}",
+	}
+
+	for _, value := range values {
+		formatted := FormatAttributeValue(value)
+		result, err := parseRawAttributes([]byte("key=" + formatted))
+		require.NoError(t, err, "formatted: %s", formatted)
+		assert.Equal(t, value, result["key"], "formatted: %s", formatted)
+	}
+
+	assert.Equal(t, "echo", FormatAttributeValue("echo"))
+	assert.Equal(t, `"push to prod"`, FormatAttributeValue("push to prod"))
+	assert.Equal(t, `["-v", "--force"]`, FormatAttributeValue(`["-v", "--force"]`))
+}
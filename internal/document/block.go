@@ -16,8 +16,19 @@ const (
 	MarkdownBlockKind
 )
 
+// OutputLanguage is the language of a fenced code block containing
+// an output of the preceding code block, for example:
+//
+//	```output { exitCode=0 }
+//	Hello, runme!
+//	```
+//
+// Such blocks are not runnable. See editor.CellOutput.
+const OutputLanguage = "output"
+
 type Block interface {
 	Kind() BlockKind
+	Range() Range
 	Unwrap() ast.Node
 	Value() []byte
 }
@@ -26,7 +37,17 @@ type Blocks []Block
 
 type CodeBlocks []*CodeBlock
 
+// Lookup returns a code block with the given ID or name.
+// IDs take precedence as, unlike names, they are stable.
 func (b CodeBlocks) Lookup(name string) *CodeBlock {
+	if name == "" {
+		return nil
+	}
+	for _, block := range b {
+		if block.ID() == name {
+			return block
+		}
+	}
 	for _, block := range b {
 		if block.Name() == name {
 			return block
@@ -42,58 +63,124 @@ func (b CodeBlocks) Names() (result []string) {
 	return result
 }
 
+func (b CodeBlocks) IDs() (result []string) {
+	for _, block := range b {
+		if id := block.ID(); id != "" {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
 type Renderer func(ast.Node, []byte) ([]byte, error)
 
+// renderedValue renders a node on first use. Rendering takes most
+// of the time of parsing, and many commands need only a few blocks,
+// for example, the one to run.
+type renderedValue struct {
+	node   ast.Node
+	source []byte
+	render Renderer
+	value  []byte
+	done   bool
+}
+
+func newRenderedValue(node ast.Node, source []byte, render Renderer) *renderedValue {
+	return &renderedValue{node: node, source: source, render: render}
+}
+
+// get returns the rendered value. Errors are recorded
+// by the renderer of the document, see Document.Err.
+func (v *renderedValue) get() []byte {
+	if !v.done {
+		v.value, _ = v.render(v.node, v.source)
+		v.done = true
+	}
+	return v.value
+}
+
 type CodeBlock struct {
-	attributes map[string]string
-	inner      *ast.FencedCodeBlock
-	intro      string
-	language   string
-	lines      []string
-	name       string
-	value      []byte
+	attributes       map[string]string
+	detectedLanguage string
+	detected         bool
+	filename         string
+	inner            *ast.FencedCodeBlock
+	intro            *string // computed on first use
+	language         string
+	lines            []string
+	linesResolved    bool // console commands are extracted on first use
+	name             string
+	namespace        string
+	rawLines         []string
+	repeated         []string // attributes with repeated keys
+	rng              Range
+	sections         []string
+	source           []byte
+	suffixed         bool
+	value            *renderedValue
 }
 
 func newCodeBlock(
 	node *ast.FencedCodeBlock,
 	nameResolver *nameResolver,
 	source []byte,
+	rng Range,
 	render Renderer,
-) (*CodeBlock, error) {
-	attributes := getAttributes(node, source)
-	name := getName(node, source, nameResolver)
-
-	value, err := render(node, source)
-	if err != nil {
-		return nil, err
+) *CodeBlock {
+	attributes, repeated := getAttributes(node, source)
+	language := getLanguage(node, source)
+	lines := getLines(node, source)
+
+	// Outputs do not take names from code blocks which can be run.
+	baseName := getBaseName(attributes, lines)
+	name := baseName
+	if language != OutputLanguage {
+		name = nameResolver.Get(node, baseName)
 	}
 
 	return &CodeBlock{
 		attributes: attributes,
 		inner:      node,
-		intro:      getIntro(node, source),
-		language:   getLanguage(node, source),
-		lines:      getLines(node, source),
+		language:   language,
+		lines:      lines,
 		name:       name,
-		value:      value,
-	}, nil
+		rawLines:   getRawLines(node, source),
+		repeated:   repeated,
+		rng:        rng,
+		source:     source,
+		suffixed:   name != baseName,
+		value:      newRenderedValue(node, source, render),
+	}
 }
 
 func (b *CodeBlock) Attributes() map[string]string { return b.attributes }
 
+// RepeatedAttributes returns keys of attributes which were repeated,
+// like "tag" in "{ tag=a tag=b }". Their values are JSON arrays.
+func (b *CodeBlock) RepeatedAttributes() []string { return b.repeated }
+
 func (CodeBlock) Kind() BlockKind { return CodeBlockKind }
 
 func (b *CodeBlock) Content() []byte {
-	value := bytes.Trim(b.value, "\n")
+	// Code blocks of other formats than Markdown have no fences to trim.
+	if b.inner == nil {
+		return []byte(strings.Join(b.rawLines, "\n"))
+	}
+	value := bytes.Trim(b.Value(), "\n")
 	lines := bytes.Split(value, []byte{'\n'})
 	if len(lines) < 2 {
-		return b.value
+		return b.Value()
 	}
 	return bytes.Join(lines[1:len(lines)-1], []byte{'\n'})
 }
 
+// Intro returns the text preceding the code block.
 func (b *CodeBlock) Intro() string {
-	return b.intro
+	if b.intro == nil {
+		intro := getIntro(b.inner, b.source)
+		b.intro = &intro
+	}
+	return *b.intro
 }
 
 func (b *CodeBlock) Language() string {
@@ -101,6 +188,18 @@ func (b *CodeBlock) Language() string {
 }
 
 func (b *CodeBlock) Lines() []string {
+	// Console blocks contain output which must not be executed.
+	// Commands are extracted once so that changes of the lines,
+	// for example, made by replace scripts, are kept. Blocks
+	// without prompts are taken as they are.
+	if !b.linesResolved {
+		if IsConsole(b.ProbableLanguage()) {
+			if lines := consoleLines(b.rawLines); len(lines) > 0 {
+				b.lines = lines
+			}
+		}
+		b.linesResolved = true
+	}
 	return b.lines
 }
 
@@ -108,59 +207,35 @@ func (b *CodeBlock) Name() string {
 	return b.name
 }
 
-func (b *CodeBlock) Unwrap() ast.Node {
-	return b.inner
-}
-
-func (b *CodeBlock) Value() []byte {
-	return b.value
+// NameSuffixed reports whether a number was appended
+// to the name of the code block to make it unique.
+func (b *CodeBlock) NameSuffixed() bool {
+	return b.suffixed
 }
 
-func rawAttributes(source []byte) []byte {
-	start, stop := -1, -1
-
-	for i := 0; i < len(source); i++ {
-		if start == -1 && source[i] == '{' && i+1 < len(source) && source[i+1] != '}' {
-			start = i + 1
-		}
-		if stop == -1 && source[i] == '}' {
-			stop = i
-			break
-		}
-	}
-
-	if start >= 0 && stop >= 0 {
-		return bytes.TrimSpace(source[start:stop])
-	}
-
-	return nil
+func (b *CodeBlock) Range() Range {
+	return b.rng
 }
 
-func parseRawAttributes(source []byte) map[string]string {
-	items := bytes.Split(source, []byte{' '})
-	if len(items) == 0 {
+func (b *CodeBlock) Unwrap() ast.Node {
+	if b.inner == nil {
 		return nil
 	}
+	return b.inner
+}
 
-	result := make(map[string]string)
-
-	for _, item := range items {
-		if !bytes.Contains(item, []byte{'='}) {
-			continue
-		}
-		kv := bytes.Split(item, []byte{'='})
-		result[string(kv[0])] = string(kv[1])
-	}
-
-	return result
+func (b *CodeBlock) Value() []byte {
+	return b.value.get()
 }
 
-func getAttributes(node *ast.FencedCodeBlock, source []byte) map[string]string {
-	attributes := make(map[string]string)
+func getAttributes(node *ast.FencedCodeBlock, source []byte) (attributes map[string]string, repeated []string) {
+	attributes = make(map[string]string)
 	if node.Info != nil {
-		attributes = parseRawAttributes(rawAttributes(node.Info.Text(source)))
+		// Malformed attributes are ignored; the ones parsed
+		// before the error are still returned.
+		attributes, repeated, _ = parseRawAttributesRepeated(rawAttributes(node.Info.Text(source)))
 	}
-	return attributes
+	return attributes, repeated
 }
 
 func getLanguage(node *ast.FencedCodeBlock, source []byte) string {
@@ -226,52 +301,47 @@ func sanitizeName(s string) string {
 	return b.String()
 }
 
-func getName(node *ast.FencedCodeBlock, source []byte, nameResolver *nameResolver) string {
-	attributes := make(map[string]string)
-	if node.Info != nil {
-		attributes = parseRawAttributes(rawAttributes(node.Info.Text(source)))
+func getBaseName(attributes map[string]string, lines []string) string {
+	if name := attributes["name"]; name != "" {
+		return name
 	}
-
-	var name string
-	if n, ok := attributes["name"]; ok && n != "" {
-		name = n
-	} else {
-		lines := getLines(node, source)
-		if len(lines) > 0 {
-			name = sanitizeName(lines[0])
-		}
+	if len(lines) > 0 {
+		return sanitizeName(lines[0])
 	}
-	return nameResolver.Get(node, name)
+	return ""
 }
 
 type MarkdownBlock struct {
 	inner ast.Node
-	value []byte
+	rng   Range
+	value *renderedValue
 }
 
 func newMarkdownBlock(
 	node ast.Node,
 	source []byte,
+	rng Range,
 	render Renderer,
-) (*MarkdownBlock, error) {
-	value, err := render(node, source)
-	if err != nil {
-		return nil, err
-	}
+) *MarkdownBlock {
 	return &MarkdownBlock{
 		inner: node,
-		value: value,
-	}, nil
+		rng:   rng,
+		value: newRenderedValue(node, source, render),
+	}
 }
 
 func (MarkdownBlock) Kind() BlockKind { return MarkdownBlockKind }
 
+func (b *MarkdownBlock) Range() Range {
+	return b.rng
+}
+
 func (b *MarkdownBlock) Unwrap() ast.Node {
 	return b.inner
 }
 
 func (b *MarkdownBlock) Value() []byte {
-	return b.value
+	return b.value.get()
 }
 
 // InnerBlock represents a non-leaf block.
@@ -279,30 +349,33 @@ func (b *MarkdownBlock) Value() []byte {
 // for block quotes and list items.
 type InnerBlock struct {
 	inner ast.Node
-	value []byte
+	rng   Range
+	value *renderedValue
 }
 
 func newInnerBlock(
 	node ast.Node,
 	source []byte,
+	rng Range,
 	render Renderer,
-) (*InnerBlock, error) {
-	value, err := render(node, source)
-	if err != nil {
-		return nil, err
-	}
+) *InnerBlock {
 	return &InnerBlock{
 		inner: node,
-		value: value,
-	}, nil
+		rng:   rng,
+		value: newRenderedValue(node, source, render),
+	}
 }
 
 func (InnerBlock) Kind() BlockKind { return InnerBlockKind }
 
+func (b *InnerBlock) Range() Range {
+	return b.rng
+}
+
 func (b *InnerBlock) Unwrap() ast.Node {
 	return b.inner
 }
 
 func (b *InnerBlock) Value() []byte {
-	return b.value
+	return b.value.get()
 }
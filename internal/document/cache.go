@@ -0,0 +1,96 @@
+package document
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"sync"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+const (
+	// astCacheSize limits the memory taken by cached ASTs.
+	astCacheSize = 64 << 20
+	// astNodeSize is the approximate memory taken by a node of an AST,
+	// including its segments. ASTs take tens of times more memory than
+	// their sources, hence the cost of an entry is estimated from them.
+	astNodeSize = 300
+)
+
+// astCache caches ASTs by SHA-256 hashes of their sources. The same
+// source is often parsed repeatedly: the editor deserializes a document
+// on every change and commands in a project read the same files.
+// ASTs are never modified after parsing, hence they are shared.
+type astCache struct {
+	mu      sync.Mutex
+	entries map[[sha256.Size]byte]*list.Element
+	lru     *list.List // of *astCacheEntry, the most recently used first
+	size    int
+	maxSize int
+}
+
+type astCacheEntry struct {
+	key  [sha256.Size]byte
+	node ast.Node
+	size int
+}
+
+func newASTCache(maxSize int) *astCache {
+	return &astCache{
+		entries: make(map[[sha256.Size]byte]*list.Element),
+		lru:     list.New(),
+		maxSize: maxSize,
+	}
+}
+
+var defaultASTCache = newASTCache(astCacheSize)
+
+// parse returns the AST of the source, parsing it with p if it is
+// not cached. ASTs larger than the cache are not cached at all.
+func (c *astCache) parse(p parser.Parser, source []byte) ast.Node {
+	key := sha256.Sum256(source)
+
+	c.mu.Lock()
+	if elem, ok := c.entries[key]; ok {
+		c.lru.MoveToFront(elem)
+		c.mu.Unlock()
+		return elem.Value.(*astCacheEntry).node
+	}
+	c.mu.Unlock()
+
+	node := p.Parse(text.NewReader(source))
+
+	size := astSize(node)
+	if size > c.maxSize {
+		return node
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; !ok {
+		c.entries[key] = c.lru.PushFront(&astCacheEntry{key: key, node: node, size: size})
+		c.size += size
+	}
+	for c.size > c.maxSize {
+		entry := c.lru.Remove(c.lru.Back()).(*astCacheEntry)
+		delete(c.entries, entry.key)
+		c.size -= entry.size
+	}
+
+	return node
+}
+
+// astSize estimates the memory taken by the AST.
+func astSize(node ast.Node) int {
+	count := 0
+	_ = ast.Walk(node, func(_ ast.Node, entering bool) (ast.WalkStatus, error) {
+		if entering {
+			count++
+		}
+		return ast.WalkContinue, nil
+	})
+	return count * astNodeSize
+}
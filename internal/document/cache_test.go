@@ -0,0 +1,28 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestASTCache(t *testing.T) {
+	// Each source has 5 nodes.
+	cache := newASTCache(8 * astNodeSize)
+	p := NewParser()
+
+	first := cache.parse(p, []byte("# Title\n\nText.\n"))
+	assert.Same(t, first, cache.parse(p, []byte("# Title\n\nText.\n")))
+	assert.Equal(t, 1, cache.lru.Len())
+
+	// The least recently used entry is evicted.
+	second := cache.parse(p, []byte("# Other\n\nText.\n"))
+	assert.Equal(t, 1, cache.lru.Len())
+	assert.Same(t, second, cache.parse(p, []byte("# Other\n\nText.\n")))
+	assert.NotSame(t, first, cache.parse(p, []byte("# Title\n\nText.\n")))
+
+	// ASTs larger than the cache are not cached.
+	cache.parse(p, []byte("# Title\n\n*Text* with **more** `nodes`.\n"))
+	assert.Equal(t, 1, cache.lru.Len())
+	assert.Equal(t, 5*astNodeSize, cache.size)
+}
@@ -0,0 +1,107 @@
+package document
+
+import (
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// consoleLanguages are languages of code blocks containing a transcript
+// of a shell session, i.e. commands with a "$ " prompt and their output.
+var consoleLanguages = []string{
+	"console",
+	"shell-session",
+	"sh-session",
+}
+
+// IsConsole reports whether the language is used for transcripts
+// of shell sessions.
+func IsConsole(lang string) bool {
+	for _, item := range consoleLanguages {
+		if item == lang {
+			return true
+		}
+	}
+	return false
+}
+
+// ConsoleCommand is a command from a console code block
+// together with its documented output.
+type ConsoleCommand struct {
+	// Lines are lines of the command without the prompt.
+	// There is more than one line if the command is continued with "\".
+	Lines []string
+	// Output is the expected output of the command.
+	Output string
+}
+
+// ConsoleCommands returns commands of a console code block, see IsConsole.
+// For other code blocks it returns nil.
+func (b *CodeBlock) ConsoleCommands() []ConsoleCommand {
+	if !IsConsole(b.ProbableLanguage()) {
+		return nil
+	}
+	return parseConsole(b.rawLines)
+}
+
+func getRawLines(node *ast.FencedCodeBlock, source []byte) []string {
+	var result []string
+	for i := 0; i < node.Lines().Len(); i++ {
+		line := node.Lines().At(i)
+		result = append(result, strings.TrimRight(string(line.Value(source)), "\r\n"))
+	}
+	return result
+}
+
+// parseConsole splits lines of a console session into commands, which
+// start with the "$" prompt, and their output. Lines continuing a command
+// ending with "\" belong to it; an optional "> " secondary prompt is removed.
+// Output preceding the first command is ignored.
+func parseConsole(lines []string) (result []ConsoleCommand) {
+	var (
+		current      *ConsoleCommand
+		output       []string
+		continuation bool
+	)
+
+	flush := func() {
+		if current == nil {
+			return
+		}
+		current.Output = strings.Join(output, "\n")
+		result = append(result, *current)
+		current, output = nil, nil
+	}
+
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case continuation && current != nil:
+			trimmed = strings.TrimSpace(strings.TrimPrefix(trimmed, ">"))
+			current.Lines = append(current.Lines, trimmed)
+		case trimmed == "$" || strings.HasPrefix(trimmed, "$ "):
+			flush()
+			current = &ConsoleCommand{Lines: []string{normalizeLine(trimmed)}}
+		default:
+			if current != nil {
+				output = append(output, line)
+			}
+			continuation = false
+			continue
+		}
+
+		continuation = strings.HasSuffix(trimmed, "\\")
+	}
+
+	flush()
+
+	return result
+}
+
+func consoleLines(lines []string) (result []string) {
+	for _, cmd := range parseConsole(lines) {
+		result = append(result, cmd.Lines...)
+	}
+	return result
+}
@@ -0,0 +1,55 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeBlock_ConsoleCommands(t *testing.T) {
+	blocks := testCodeBlocks(t, "```console { name=session }\n"+
+		"Welcome!\n"+
+		"$ echo hello\n"+
+		"hello\n"+
+		"$ docker run \\\n"+
+		">   --rm alpine \\\n"+
+		"    echo hi\n"+
+		"hi\n"+
+		"\n"+
+		"  indented output\n"+
+		"$ true\n"+
+		"```\n\n"+
+		"```sh\n$ echo 1\n1\n```\n")
+
+	require.Len(t, blocks, 2)
+
+	session := blocks[0]
+	assert.Equal(t, []string{"echo hello", "docker run \\", "--rm alpine \\", "echo hi", "true"}, session.Lines())
+	assert.Equal(
+		t,
+		[]ConsoleCommand{
+			{Lines: []string{"echo hello"}, Output: "hello"},
+			{Lines: []string{"docker run \\", "--rm alpine \\", "echo hi"}, Output: "hi\n\n  indented output"},
+			{Lines: []string{"true"}, Output: ""},
+		},
+		session.ConsoleCommands(),
+	)
+
+	// Other languages are not split.
+	assert.Nil(t, blocks[1].ConsoleCommands())
+	assert.Equal(t, []string{"echo 1", "1"}, blocks[1].Lines())
+}
+
+func TestCodeBlock_ConsoleWithoutPrompts(t *testing.T) {
+	blocks := testCodeBlocks(t, "```shell-session\necho 1\necho 2\n```\n")
+	require.Len(t, blocks, 1)
+	assert.Empty(t, blocks[0].ConsoleCommands())
+	assert.Equal(t, []string{"echo 1", "echo 2"}, blocks[0].Lines())
+}
+
+func TestIsConsole(t *testing.T) {
+	assert.True(t, IsConsole("console"))
+	assert.True(t, IsConsole("shell-session"))
+	assert.False(t, IsConsole("sh"))
+}
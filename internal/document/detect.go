@@ -0,0 +1,204 @@
+package document
+
+import (
+	"encoding/json"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// ProbableLanguage returns the language of the code block or,
+// if there is none, a language detected from its content and
+// the preceding text. It is empty if detection fails.
+func (b *CodeBlock) ProbableLanguage() string {
+	if b.language != "" {
+		return b.language
+	}
+	return b.detectLanguage()
+}
+
+// LanguageDetected reports whether the language returned by
+// ProbableLanguage was detected rather than declared.
+func (b *CodeBlock) LanguageDetected() bool {
+	return b.language == "" && b.detectLanguage() != ""
+}
+
+// detectLanguage detects the language on first use
+// as it is needed only for code blocks without one.
+func (b *CodeBlock) detectLanguage() string {
+	if b.language == "" && !b.detected {
+		b.detectedLanguage = DetectLanguage(b.rawLines, b.Intro())
+		b.detected = true
+	}
+	return b.detectedLanguage
+}
+
+// DetectLanguage returns a probable language of a code block
+// using offline heuristics: a shebang, a JSON document, "$ " prompts,
+// characteristic keywords, and a file name mentioned in the intro.
+// It returns an empty string if no language is probable enough.
+func DetectLanguage(lines []string, intro string) string {
+	var content []string
+	for _, line := range lines {
+		if strings.TrimSpace(line) != "" {
+			content = append(content, line)
+		}
+	}
+	if len(content) == 0 {
+		return ""
+	}
+
+	if lang := detectShebang(content[0]); lang != "" {
+		return lang
+	}
+
+	if text := strings.TrimSpace(strings.Join(content, "\n")); (text[0] == '{' || text[0] == '[') && json.Valid([]byte(text)) {
+		return "json"
+	}
+
+	if lang := detectPrompts(content); lang != "" {
+		return lang
+	}
+
+	if lang := detectKeywords(content); lang != "" {
+		return lang
+	}
+
+	return detectFileName(intro)
+}
+
+var shebangInterpreters = map[string]string{
+	"bash":    "bash",
+	"sh":      "sh",
+	"zsh":     "zsh",
+	"python":  "python",
+	"python3": "python",
+	"node":    "javascript",
+	"deno":    "typescript",
+	"ruby":    "ruby",
+	"perl":    "perl",
+}
+
+func detectShebang(line string) string {
+	if !strings.HasPrefix(line, "#!") {
+		return ""
+	}
+	fields := strings.Fields(line[2:])
+	if len(fields) == 0 {
+		return ""
+	}
+	interpreter := path.Base(fields[0])
+	if interpreter == "env" && len(fields) > 1 {
+		interpreter = fields[1]
+	}
+	return shebangInterpreters[interpreter]
+}
+
+func detectPrompts(lines []string) string {
+	if !strings.HasPrefix(strings.TrimSpace(lines[0]), "$ ") {
+		return ""
+	}
+	for _, line := range parseConsole(lines) {
+		if line.Output != "" {
+			return "console"
+		}
+	}
+	return "sh"
+}
+
+var (
+	fileNameRe = regexp.MustCompile(`(?:^|[\s"'` + "`" + `(])([\w./-]*?(?:\.[A-Za-z]+|Dockerfile|Makefile))(?:$|[\s"'` + "`" + `:,.)])`)
+
+	extensionLanguages = map[string]string{
+		".go":   "go",
+		".py":   "python",
+		".js":   "javascript",
+		".mjs":  "javascript",
+		".ts":   "typescript",
+		".json": "json",
+		".yaml": "yaml",
+		".yml":  "yaml",
+		".toml": "toml",
+		".ini":  "ini",
+		".rb":   "ruby",
+		".pl":   "perl",
+		".sh":   "sh",
+		".bash": "bash",
+		".zsh":  "zsh",
+		".sql":  "sql",
+		".rs":   "rust",
+		".html": "html",
+		".css":  "css",
+		".xml":  "xml",
+	}
+)
+
+// detectFileName looks for a file name, like "main.go", in the text
+// preceding the code block, for example, "Create main.go with:".
+// Only the last mentioned file is taken into account.
+func detectFileName(intro string) string {
+	matches := fileNameRe.FindAllStringSubmatch(intro, -1)
+	for i := len(matches) - 1; i >= 0; i-- {
+		name := matches[i][1]
+		switch path.Base(name) {
+		case "Dockerfile":
+			return "dockerfile"
+		case "Makefile":
+			return "makefile"
+		}
+		if lang, ok := extensionLanguages[strings.ToLower(path.Ext(name))]; ok {
+			return lang
+		}
+	}
+	return ""
+}
+
+type languagePattern struct {
+	lang string
+	re   *regexp.Regexp
+}
+
+// languagePatterns match lines characteristic for a language.
+// Each matching line adds a point to the language. In case of a tie,
+// the language listed first wins.
+var languagePatterns = []languagePattern{
+	{"sh", regexp.MustCompile(`^\s*(sudo )?(echo|cd|export|ls|cat|mkdir|rm|cp|mv|curl|wget|git|docker|kubectl|helm|npm|npx|yarn|pnpm|go|make|brew|apt|apt-get|yum|pip|pip3|python3? -m|chmod|chown|source|\.|gcloud|aws|az|terraform|runme|cargo|deno|node|open|tar|unzip|grep|sed|awk|touch|pushd|popd|ssh|scp|systemctl|set|unset|eval|if \[|for \w+ in|while |\w+=\S*$)( |$)`)},
+	{"go", regexp.MustCompile(`^(package \w+|import \(|func (\(\w+ \*?\w+\) )?\w+\(|\w+ := |type \w+ (struct|interface) \{)`)},
+	{"python", regexp.MustCompile(`^\s*(def \w+\(.*\):|class \w+(\(.*\))?:|from [\w.]+ import |import [\w.]+$|if __name__ == |print\(|elif .*:)`)},
+	{"javascript", regexp.MustCompile(`^\s*(const |let |var |function \w*\(|module\.exports|console\.log\(|.*\) => \{|.*require\(['"])`)},
+	{"yaml", regexp.MustCompile(`^\s*(- )?[\w.-]+:(\s+[^{}\[\]=]+)?$|^---$|^\s*- \S`)},
+	{"ini", regexp.MustCompile(`^\[[\w. -]+\]$|^[\w.-]+\s*=\s*.*$`)},
+	{"sql", regexp.MustCompile(`(?i)^\s*(SELECT .+ FROM|INSERT INTO|UPDATE \w+ SET|DELETE FROM|CREATE (TABLE|INDEX|DATABASE)|ALTER TABLE|DROP TABLE)\b`)},
+	{"dockerfile", regexp.MustCompile(`^(FROM \S+|RUN |COPY |ADD |WORKDIR |ENTRYPOINT |CMD \[|EXPOSE \d+)`)},
+}
+
+// detectKeywords returns the language with the most characteristic
+// lines, provided they make up at least half of the lines.
+func detectKeywords(lines []string) string {
+	scores := make(map[string]int)
+	for _, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") && !strings.HasPrefix(line, "#!") {
+			continue
+		}
+		for _, p := range languagePatterns {
+			if p.re.MatchString(line) {
+				scores[p.lang]++
+			}
+		}
+	}
+
+	var (
+		best      string
+		bestScore int
+	)
+	for _, p := range languagePatterns {
+		if score := scores[p.lang]; score > bestScore {
+			best, bestScore = p.lang, score
+		}
+	}
+
+	if bestScore*2 < len(lines) {
+		return ""
+	}
+	return best
+}
@@ -0,0 +1,61 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectLanguage(t *testing.T) {
+	testCases := []struct {
+		name     string
+		lines    []string
+		intro    string
+		expected string
+	}{
+		{"Empty", []string{"", " "}, "", ""},
+		{"Shebang", []string{"#!/usr/bin/env python3", "x = 1"}, "", "python"},
+		{"ShebangBash", []string{"#!/bin/bash", "echo 1"}, "", "bash"},
+		{"JSON", []string{"{", `  "key": "value"`, "}"}, "", "json"},
+		{"Prompt", []string{"$ echo 1", "$ echo 2"}, "", "sh"},
+		{"PromptWithOutput", []string{"$ echo 1", "1"}, "", "console"},
+		{"Shell", []string{"# install deps", "npm install", "export PORT=8080", "npm start"}, "", "sh"},
+		{"Assignment", []string{"FOO=bar"}, "", "sh"},
+		{"Go", []string{"package main", "", "func main() {", "\tx := 1", "}"}, "", "go"},
+		{"Python", []string{"def hello():", `    print("Hello")`}, "", "python"},
+		{"YAML", []string{"apiVersion: v1", "kind: Pod", "metadata:", "  name: test"}, "", "yaml"},
+		{"INI", []string{"[database]", "username = admin", "password = admin"}, "", "ini"},
+		{"SQL", []string{"SELECT * FROM users;"}, "", "sql"},
+		{"Dockerfile", []string{"FROM golang:1.19", "WORKDIR /app", "RUN go build"}, "", "dockerfile"},
+		{"FileName", []string{"some content"}, "Create `config.toml` with:", "toml"},
+		{"LastFileName", []string{"some content"}, "Copy main.go to app.py.", "python"},
+		{"Makefile", []string{"include common.mk"}, "Add it to the Makefile.", "makefile"},
+		{"Unknown", []string{"some content", "more content"}, "Notes.", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, DetectLanguage(tc.lines, tc.intro))
+		})
+	}
+}
+
+func TestCodeBlock_ProbableLanguage(t *testing.T) {
+	blocks := testCodeBlocks(t, "```\nexport A=1\n```\n\n```yaml\nkey: value\n```\n\n```\n$ echo 1\n1\n```\n\n```\nsome content\n```\n")
+	require.Len(t, blocks, 4)
+
+	assert.Equal(t, "", blocks[0].Language())
+	assert.Equal(t, "sh", blocks[0].ProbableLanguage())
+	assert.True(t, blocks[0].LanguageDetected())
+
+	assert.Equal(t, "yaml", blocks[1].ProbableLanguage())
+	assert.False(t, blocks[1].LanguageDetected())
+
+	// Detected console blocks are split into commands like declared ones.
+	assert.Equal(t, "console", blocks[2].ProbableLanguage())
+	assert.Equal(t, []string{"echo 1"}, blocks[2].Lines())
+
+	assert.Equal(t, "", blocks[3].ProbableLanguage())
+	assert.False(t, blocks[3].LanguageDetected())
+}
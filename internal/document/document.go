@@ -2,35 +2,89 @@ package document
 
 import (
 	"fmt"
+	"io/fs"
 
 	"github.com/pkg/errors"
 	"github.com/yuin/goldmark"
 	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
 	"github.com/yuin/goldmark/parser"
-	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
 )
 
 type Document struct {
 	astNode      ast.Node
+	base         Position
+	filename     string
+	fsys         fs.FS
+	headings     headings
+	included     map[string]bool // files included in the whole tree
+	includes     []string
+	lines        lineIndex
 	nameResolver *nameResolver
+	namespace    string
 	node         *Node
 	parser       parser.Parser
+	renderErr    error
 	renderer     Renderer
 	source       []byte
 }
 
+// NewParser returns a Markdown parser supporting GitHub Flavored
+// Markdown: tables, strikethrough, task lists, and footnotes.
+// Autolinks are not extended as they would not round-trip.
+func NewParser() parser.Parser {
+	p := goldmark.New(
+		goldmark.WithExtensions(
+			extension.Table,
+			extension.Strikethrough,
+			extension.TaskList,
+		),
+	).Parser()
+	// Unlike extension.Footnote, the AST transformer is omitted
+	// so that footnote definitions stay in place, even if they
+	// are not referenced. It is only needed to render HTML.
+	p.AddOptions(
+		parser.WithBlockParsers(
+			util.Prioritized(extension.NewFootnoteBlockParser(), 999),
+		),
+		parser.WithInlineParsers(
+			util.Prioritized(extension.NewFootnoteParser(), 101),
+		),
+	)
+	return p
+}
+
 func New(source []byte, renderer Renderer) *Document {
 	return &Document{
 		nameResolver: &nameResolver{
 			namesCounter: map[string]int{},
 			cache:        map[interface{}]string{},
 		},
-		parser:   goldmark.DefaultParser(),
+		parser:   NewParser(),
 		renderer: renderer,
 		source:   source,
 	}
 }
 
+// WithBase sets the position of the source in a larger document,
+// for example, one with front matter. Ranges of blocks are reported
+// relative to it. It must be called before Parse.
+func (d *Document) WithBase(base Position) *Document {
+	d.base = base
+	return d
+}
+
+// WithFS enables include directives (see IncludeLanguage).
+// filename is the name of the document's source in fsys
+// and included files are resolved relative to it.
+// It must be called before Parse.
+func (d *Document) WithFS(fsys fs.FS, filename string) *Document {
+	d.fsys = fsys
+	d.filename = filename
+	return d
+}
+
 func (d *Document) Parse() (*Node, ast.Node, error) {
 	if d.astNode == nil {
 		d.astNode = d.parse()
@@ -41,45 +95,79 @@ func (d *Document) Parse() (*Node, ast.Node, error) {
 		if err := d.buildBlocksTree(d.astNode, node); err != nil {
 			return nil, nil, errors.WithStack(err)
 		}
+		if d.included != nil && len(d.includes) == 0 {
+			if err := checkIncludedNames(CollectCodeBlocks(node)); err != nil {
+				return nil, nil, err
+			}
+		}
 		d.node = node
 	}
 
 	return d.node, d.astNode, nil
 }
 
+// Err returns the first error of rendering blocks. Blocks are rendered
+// on first use, for example, by Value(), hence errors are known only
+// afterwards.
+func (d *Document) Err() error {
+	return d.renderErr
+}
+
+// render renders nodes with the renderer of the document
+// and records the first error. See Err.
+func (d *Document) render(node ast.Node, source []byte) ([]byte, error) {
+	value, err := d.renderer(node, source)
+	if err != nil && d.renderErr == nil {
+		d.renderErr = errors.Wrap(err, "failed to render")
+	}
+	return value, err
+}
+
 func (d *Document) parse() ast.Node {
-	return d.parser.Parse(text.NewReader(d.source))
+	return defaultASTCache.parse(d.parser, d.source)
+}
+
+func (d *Document) nodeRange(astNode ast.Node) Range {
+	if d.lines == nil {
+		d.lines = newLineIndex(d.source)
+	}
+	start, stop := nodeRange(astNode, d.source)
+	return d.lines.newRange(start, stop, d.base)
 }
 
 func (d *Document) buildBlocksTree(parent ast.Node, node *Node) error {
 	for astNode := parent.FirstChild(); astNode != nil; astNode = astNode.NextSibling() {
+		rng := d.nodeRange(astNode)
+
 		switch astNode.Kind() {
 		case ast.KindFencedCodeBlock:
-			block, err := newCodeBlock(
+			block := newCodeBlock(
 				astNode.(*ast.FencedCodeBlock),
 				d.nameResolver,
 				d.source,
-				d.renderer,
+				rng,
+				d.render,
 			)
-			if err != nil {
-				return errors.WithStack(err)
+			if d.fsys != nil && block.Language() == IncludeLanguage {
+				if err := d.include(block, node); err != nil {
+					return err
+				}
+				continue
 			}
+			block.filename = d.filename
+			block.sections = d.headings.titles()
+			block.setNamespace(d.namespace)
 			node.add(block)
 		case ast.KindBlockquote, ast.KindList, ast.KindListItem:
-			block, err := newInnerBlock(astNode, d.source, d.renderer)
-			if err != nil {
-				return errors.WithStack(err)
-			}
-			nNode := node.add(block)
+			nNode := node.add(newInnerBlock(astNode, d.source, rng, d.render))
 			if err := d.buildBlocksTree(astNode, nNode); err != nil {
 				return err
 			}
 		default:
-			block, err := newMarkdownBlock(astNode, d.source, d.renderer)
-			if err != nil {
-				return errors.WithStack(err)
+			node.add(newMarkdownBlock(astNode, d.source, rng, d.render))
+			if heading, ok := astNode.(*ast.Heading); ok {
+				d.headings = d.headings.push(heading.Level, string(heading.Text(d.source)))
 			}
-			node.add(block)
 		}
 	}
 	return nil
@@ -1,11 +1,15 @@
 package document
 
 import (
+	"bytes"
+	"fmt"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stateful/runme/internal/renderer/cmark"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/yuin/goldmark/ast"
 )
 
 func TestDocument_Parse(t *testing.T) {
@@ -63,3 +67,97 @@ First paragraph.
 	assert.Len(t, node.children[3].children[2].children[0].children, 0)
 	assert.Equal(t, "Item 3\n", string(node.children[3].children[2].children[0].Item().Value()))
 }
+
+func TestDocument_LazyRender(t *testing.T) {
+	renders := 0
+	render := func(node ast.Node, source []byte) ([]byte, error) {
+		renders++
+		return cmark.Render(node, source)
+	}
+
+	data := []byte("# Title\n\n```sh { name=first }\necho 1\n```\n\n- item\n")
+	node, _, err := New(data, render).Parse()
+	require.NoError(t, err)
+	assert.Equal(t, 0, renders)
+
+	block := CollectCodeBlocks(node).Lookup("first")
+	require.NotNil(t, block)
+	assert.Equal(t, []byte("echo 1"), block.Content())
+	assert.Equal(t, "```sh { name=first }\necho 1\n```\n", string(block.Value()))
+	assert.Equal(t, 1, renders)
+}
+
+func TestDocument_RenderError(t *testing.T) {
+	render := func(ast.Node, []byte) ([]byte, error) {
+		return nil, errors.New("out of ink")
+	}
+
+	doc := New([]byte("# Title\n\n```sh { name=first }\necho 1\n```\n"), render)
+	node, _, err := doc.Parse()
+	require.NoError(t, err)
+	assert.NoError(t, doc.Err())
+
+	block := CollectCodeBlocks(node).Lookup("first")
+	require.NotNil(t, block)
+	assert.Empty(t, block.Value())
+	assert.EqualError(t, doc.Err(), "failed to render: out of ink")
+}
+
+// largeDocument returns a document of at least size bytes
+// with the usual mix of prose, lists, tables and code blocks.
+func largeDocument(size int) []byte {
+	var buf bytes.Buffer
+	for i := 0; buf.Len() < size; i++ {
+		fmt.Fprintf(&buf, "## Section %d\n\nSome *prose* with a [link](https://runme.dev) and `code`.\n\n", i)
+		fmt.Fprintf(&buf, "- Item %d\n- Item with **bold** text\n\n", i)
+		fmt.Fprintf(&buf, "| Name | Value |\n| --- | --- |\n| a%d | b |\n\n", i)
+		fmt.Fprintf(&buf, "```sh { name=step-%d }\necho %d\nls -la\n```\n\n", i, i)
+		fmt.Fprintf(&buf, "```\n[section]\nkey = %d\n```\n\n", i)
+	}
+	return buf.Bytes()
+}
+
+func BenchmarkDocument_Parse(b *testing.B) {
+	data := largeDocument(4 << 20)
+
+	parse := func(b *testing.B) {
+		node, _, err := New(data, cmark.Render).Parse()
+		if err != nil {
+			b.Fatal(err)
+		}
+		if blocks := CollectCodeBlocks(node); blocks.Lookup("step-100") == nil {
+			b.Fatal("block not found")
+		}
+	}
+
+	b.Run("Cold", func(b *testing.B) {
+		b.SetBytes(int64(len(data)))
+		for i := 0; i < b.N; i++ {
+			defaultASTCache = newASTCache(astCacheSize)
+			parse(b)
+		}
+	})
+
+	b.Run("Cached", func(b *testing.B) {
+		parse(b)
+		b.SetBytes(int64(len(data)))
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			parse(b)
+		}
+	})
+}
+
+func BenchmarkDocument_ParseRender(b *testing.B) {
+	data := largeDocument(4 << 20)
+	b.SetBytes(int64(len(data)))
+
+	for i := 0; i < b.N; i++ {
+		defaultASTCache = newASTCache(astCacheSize)
+		node, _, err := New(data, cmark.NewRenderer(data)).Parse()
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = node.Bytes()
+	}
+}
@@ -2,12 +2,14 @@ package editor
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"strconv"
 	"strings"
 
 	"github.com/stateful/runme/internal/document"
+	"github.com/stateful/runme/internal/langs"
 	"github.com/yuin/goldmark/ast"
 	"golang.org/x/exp/slices"
 )
@@ -15,6 +17,16 @@ import (
 const (
 	internalAttributePrefix = "runme.dev"
 	privateAttributePrefix  = "_"
+
+	// detectedLanguageAttribute stores the language detected for
+	// a code block without one. As long as the language of the cell
+	// is unchanged, it is not written back to the source.
+	detectedLanguageAttribute = "detectedLanguage"
+
+	// repeatedAttribute stores keys of attributes which were repeated,
+	// so that they are written back as "tag=a tag=b" and not as
+	// a JSON array.
+	repeatedAttribute = "repeated"
 )
 
 type CellKind int
@@ -31,6 +43,11 @@ type Cell struct {
 	Value      string            `json:"value"`
 	LanguageID string            `json:"languageId"`
 	Metadata   map[string]string `json:"metadata,omitempty"`
+	// Outputs are results of executing a code cell.
+	Outputs []*CellOutput `json:"outputs,omitempty"`
+	// TextRange is the range of the source the cell was created from.
+	// It is nil for cells which were not deserialized.
+	TextRange *document.Range `json:"textRange,omitempty"`
 }
 
 // Notebook resembles NotebookData form VS Code.
@@ -38,10 +55,17 @@ type Cell struct {
 type Notebook struct {
 	Cells    []*Cell           `json:"cells"`
 	Metadata map[string]string `json:"metadata,omitempty"`
+	// Frontmatter is a typed representation of the front matter
+	// stored in Metadata. It is informational only; Serialize
+	// uses the raw front matter from Metadata.
+	Frontmatter *document.Frontmatter `json:"frontmatter,omitempty"`
 }
 
-func toCells(node *document.Node, source []byte) (result []*Cell) {
-	toCellsRec(node, &result, source)
+// toCells converts the document to cells. If includeOutputs is true,
+// output blocks following code blocks become outputs of their cells.
+// Otherwise, they are kept as any other block.
+func toCells(node *document.Node, source []byte, includeOutputs bool) (result []*Cell) {
+	toCellsRec(node, &result, source, includeOutputs)
 	return
 }
 
@@ -49,6 +73,7 @@ func toCellsRec(
 	node *document.Node,
 	cells *[]*Cell,
 	source []byte,
+	includeOutputs bool,
 ) {
 	if node == nil {
 		return
@@ -64,8 +89,9 @@ func toCellsRec(
 				})
 				if nodeWithCode == nil {
 					*cells = append(*cells, &Cell{
-						Kind:  MarkupKind,
-						Value: fmtValue(block.Value()),
+						Kind:      MarkupKind,
+						Value:     fmtValue(block.Value()),
+						TextRange: textRange(block),
 					})
 				} else {
 					for _, listItemNode := range child.Children() {
@@ -73,11 +99,12 @@ func toCellsRec(
 							return n.Item().Kind() == document.CodeBlockKind
 						})
 						if nodeWithCode != nil {
-							toCellsRec(listItemNode, cells, source)
+							toCellsRec(listItemNode, cells, source, includeOutputs)
 						} else {
 							*cells = append(*cells, &Cell{
-								Kind:  MarkupKind,
-								Value: fmtValue(listItemNode.Item().Value()),
+								Kind:      MarkupKind,
+								Value:     fmtValue(listItemNode.Item().Value()),
+								TextRange: textRange(listItemNode.Item()),
 							})
 						}
 					}
@@ -88,32 +115,49 @@ func toCellsRec(
 					return n.Item().Kind() == document.CodeBlockKind
 				})
 				if nodeWithCode != nil {
-					toCellsRec(child, cells, source)
+					toCellsRec(child, cells, source, includeOutputs)
 				} else {
 					*cells = append(*cells, &Cell{
-						Kind:  MarkupKind,
-						Value: fmtValue(block.Value()),
+						Kind:      MarkupKind,
+						Value:     fmtValue(block.Value()),
+						TextRange: textRange(block),
 					})
 				}
 			}
 
 		case *document.CodeBlock:
+			// Outputs belong to the preceding code cell.
+			if includeOutputs && block.Language() == document.OutputLanguage {
+				if n := len(*cells); n > 0 && (*cells)[n-1].Kind == CodeKind {
+					appendOutput((*cells)[n-1], block)
+					break
+				}
+			}
+
 			// If the lang is unknown (empty) or supported then return a code cell.
 			// Otherwise, return a markup cell (#85).
-			// In the future, we will include language detection (#77).
-			if lang := block.Language(); lang == "" || isEditorSupported(lang) {
+			// Code cells without a language get a detected one, if any (#77).
+			if lang := block.Language(); lang == "" || langs.IsSupported(lang) {
 				metadata := block.Attributes()
 				metadata[prefixAttributeName(internalAttributePrefix, "name")] = block.Name()
+				if block.LanguageDetected() {
+					metadata[prefixAttributeName(internalAttributePrefix, detectedLanguageAttribute)] = block.ProbableLanguage()
+				}
+				if repeated := block.RepeatedAttributes(); len(repeated) > 0 {
+					metadata[prefixAttributeName(internalAttributePrefix, repeatedAttribute)] = strings.Join(repeated, " ")
+				}
 				*cells = append(*cells, &Cell{
 					Kind:       CodeKind,
 					Value:      string(block.Content()),
-					LanguageID: block.Language(),
+					LanguageID: block.ProbableLanguage(),
 					Metadata:   metadata,
+					TextRange:  textRange(block),
 				})
 			} else {
 				*cells = append(*cells, &Cell{
-					Kind:  MarkupKind,
-					Value: fmtValue(block.Value()),
+					Kind:      MarkupKind,
+					Value:     fmtValue(block.Value()),
+					TextRange: textRange(block),
 				})
 			}
 
@@ -143,13 +187,19 @@ func toCellsRec(
 			}
 
 			*cells = append(*cells, &Cell{
-				Kind:  MarkupKind,
-				Value: fmtValue(value),
+				Kind:      MarkupKind,
+				Value:     fmtValue(value),
+				TextRange: textRange(block),
 			})
 		}
 	}
 }
 
+func textRange(block document.Block) *document.Range {
+	r := block.Range()
+	return &r
+}
+
 func countTrailingNewLines(b []byte) int {
 	count := 0
 	lastIdx := 0
@@ -180,11 +230,9 @@ func prefixAttributeName(prefix, name string) string {
 func serializeFencedCodeAttributes(w io.Writer, cell *Cell) {
 	// Filter out private keys, i.e. starting with "_" or "runme.dev/".
 	// A key with a name "index" that comes from VS Code is also filtered out.
-	keys := make([]string, 0, len(cell.Metadata))
-	for k := range cell.Metadata {
-		if k == "index" || strings.HasPrefix(k, privateAttributePrefix) || strings.HasPrefix(k, internalAttributePrefix) {
-			continue
-		}
+	attributes := publicAttributes(cell.Metadata)
+	keys := make([]string, 0, len(attributes))
+	for k := range attributes {
 		keys = append(keys, k)
 	}
 	// Sort attributes by key, however, keep the element
@@ -202,44 +250,44 @@ func serializeFencedCodeAttributes(w io.Writer, cell *Cell) {
 		return
 	}
 
+	repeated := strings.Fields(cell.Metadata[prefixAttributeName(internalAttributePrefix, repeatedAttribute)])
+
 	_, _ = w.Write([]byte{' ', '{', ' '})
-	i := 0
-	for _, k := range keys {
-		v := cell.Metadata[k]
-		_, _ = w.Write([]byte(fmt.Sprintf("%s=%v", k, v)))
-		i++
-		if i < len(keys) {
+	for i, k := range keys {
+		if i > 0 {
 			_, _ = w.Write([]byte{' '})
 		}
+		serializeFencedCodeAttribute(w, k, cell.Metadata[k], slices.Contains(repeated, k))
 	}
 	_, _ = w.Write([]byte{' ', '}'})
 }
 
+func serializeFencedCodeAttribute(w io.Writer, key, value string, repeated bool) {
+	// A repeated key is written back as it was, unless its value
+	// was changed and is not an array of strings anymore.
+	var values []string
+	if !repeated || json.Unmarshal([]byte(value), &values) != nil || len(values) == 0 {
+		values = []string{value}
+	}
+	for i, v := range values {
+		if i > 0 {
+			_, _ = w.Write([]byte{' '})
+		}
+		_, _ = w.Write([]byte(fmt.Sprintf("%s=%s", key, document.FormatAttributeValue(v))))
+	}
+}
+
 func serializeCells(cells []*Cell) []byte {
 	var buf bytes.Buffer
 
 	for idx, cell := range cells {
-		value := cell.Value
-
-		switch cell.Kind {
-		case CodeKind:
-			ticksCount := longestBacktickSeq(value)
-			if ticksCount < 3 {
-				ticksCount = 3
+		if source, ok := unchangedSource(cell); ok {
+			if idx == len(cells)-1 {
+				source = trimTrailingBlankLines(source)
 			}
-
-			_, _ = buf.Write(bytes.Repeat([]byte{'`'}, ticksCount))
-			_, _ = buf.WriteString(cell.LanguageID)
-
-			serializeFencedCodeAttributes(&buf, cell)
-
-			_ = buf.WriteByte('\n')
-			_, _ = buf.WriteString(cell.Value)
-			_ = buf.WriteByte('\n')
-			_, _ = buf.Write(bytes.Repeat([]byte{'`'}, ticksCount))
-
-		case MarkupKind:
-			_, _ = buf.WriteString(cell.Value)
+			_, _ = buf.WriteString(source)
+		} else {
+			serializeCell(&buf, cell)
 		}
 
 		nlRequired := 2
@@ -255,6 +303,33 @@ func serializeCells(cells []*Cell) []byte {
 	return buf.Bytes()
 }
 
+func serializeCell(buf *bytes.Buffer, cell *Cell) {
+	switch cell.Kind {
+	case CodeKind:
+		ticksCount := longestBacktickSeq(cell.Value)
+		if ticksCount < 3 {
+			ticksCount = 3
+		}
+
+		_, _ = buf.Write(bytes.Repeat([]byte{'`'}, ticksCount))
+		if cell.LanguageID != cell.Metadata[prefixAttributeName(internalAttributePrefix, detectedLanguageAttribute)] {
+			_, _ = buf.WriteString(cell.LanguageID)
+		}
+
+		serializeFencedCodeAttributes(buf, cell)
+
+		_ = buf.WriteByte('\n')
+		_, _ = buf.WriteString(cell.Value)
+		_ = buf.WriteByte('\n')
+		_, _ = buf.Write(bytes.Repeat([]byte{'`'}, ticksCount))
+
+		serializeOutputs(buf, cell.Outputs)
+
+	case MarkupKind:
+		_, _ = buf.WriteString(cell.Value)
+	}
+}
+
 func longestBacktickSeq(data string) int {
 	longest, current := 0, 0
 	for _, b := range data {
@@ -267,6 +342,9 @@ func longestBacktickSeq(data string) int {
 			current = 0
 		}
 	}
+	if current > longest {
+		longest = current
+	}
 	return longest
 }
 
@@ -278,20 +356,3 @@ func trimRightNewLine(s []byte) []byte {
 	s = bytes.TrimRight(s, "\r\n")
 	return bytes.TrimRight(s, "\n")
 }
-
-var supportedExecutables = []string{
-	"bash",
-	"bat", // fallback to sh
-	"sh",
-	"shell",
-	"zsh",
-}
-
-func isEditorSupported(lang string) bool {
-	for _, item := range supportedExecutables {
-		if item == lang {
-			return true
-		}
-	}
-	return false
-}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stateful/runme/internal/document"
+	"github.com/stateful/runme/internal/langs"
 	"github.com/stateful/runme/internal/renderer/cmark"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -75,7 +76,7 @@ func Test_toCells_DataNested(t *testing.T) {
 	doc := document.New(testDataNested, cmark.Render)
 	node, _, err := doc.Parse()
 	require.NoError(t, err)
-	cells := toCells(node, testDataNested)
+	cells := toCells(node, testDataNested, false)
 	assert.Len(t, cells, 10)
 	assert.Equal(t, "# Examples", cells[0].Value)
 	assert.Equal(t, "It can have an annotation with a name:", cells[1].Value)
@@ -98,7 +99,7 @@ func Test_toCells_Lists(t *testing.T) {
 		doc := document.New(data, cmark.Render)
 		node, _, err := doc.Parse()
 		require.NoError(t, err)
-		cells := toCells(node, data)
+		cells := toCells(node, data, false)
 		assert.Len(t, cells, 1)
 		assert.Equal(t, "1. Item 1\n2. Item 2\n3. Item 3", cells[0].Value)
 	})
@@ -114,7 +115,7 @@ func Test_toCells_Lists(t *testing.T) {
 		doc := document.New(data, cmark.Render)
 		node, _, err := doc.Parse()
 		require.NoError(t, err)
-		cells := toCells(node, data)
+		cells := toCells(node, data, false)
 		assert.Len(t, cells, 4)
 		assert.Equal(t, "1. Item 1", cells[0].Value)
 		assert.Equal(t, "2. Item 2", cells[1].Value)
@@ -131,27 +132,52 @@ echo 1
 	doc := document.New(data, cmark.Render)
 	node, _, err := doc.Parse()
 	require.NoError(t, err)
-	cells := toCells(node, data)
+	cells := toCells(node, data, false)
 	assert.Len(t, cells, 1)
 	cell := cells[0]
 	assert.Equal(t, CodeKind, cell.Kind)
 	assert.Equal(t, "echo 1", cell.Value)
+	assert.Equal(t, "sh", cell.LanguageID)
+	assert.Equal(t, "sh", cell.Metadata["runme.dev/detectedLanguage"])
 }
 
 func Test_toCells_UnsupportedLang(t *testing.T) {
-	data := []byte("```py { readonly=true }" + `
-def hello():
-    print("Hello World")
+	data := []byte("```rust { readonly=true }" + `
+fn main() {
+    println!("Hello World");
+}
 ` + "```" + `
 `)
 	doc := document.New(data, cmark.Render)
 	node, _, err := doc.Parse()
 	require.NoError(t, err)
-	cells := toCells(node, data)
+	cells := toCells(node, data, false)
 	assert.Len(t, cells, 1)
 	cell := cells[0]
 	assert.Equal(t, MarkupKind, cell.Kind)
-	assert.Equal(t, "```py { readonly=true }\ndef hello():\n    print(\"Hello World\")\n```", cell.Value)
+	assert.Equal(t, "```rust { readonly=true }\nfn main() {\n    println!(\"Hello World\");\n}\n```", cell.Value)
+}
+
+func Test_toCells_RegisteredLang(t *testing.T) {
+	t.Cleanup(func() { langs.Unregister("python-test") })
+
+	data := []byte("```python-test\nprint(1)\n```\n\n```console\n$ echo 1\n1\n```\n")
+	doc := document.New(data, cmark.Render)
+	node, _, err := doc.Parse()
+	require.NoError(t, err)
+
+	cells := toCells(node, data, false)
+	require.Len(t, cells, 2)
+	assert.Equal(t, MarkupKind, cells[0].Kind)
+	assert.Equal(t, CodeKind, cells[1].Kind)
+	assert.Equal(t, "console", cells[1].LanguageID)
+
+	langs.Register("python-test")
+
+	cells = toCells(node, data, false)
+	require.Len(t, cells, 2)
+	assert.Equal(t, CodeKind, cells[0].Kind)
+	assert.Equal(t, "python-test", cells[0].LanguageID)
 }
 
 func Test_serializeCells_Edited(t *testing.T) {
@@ -168,7 +194,7 @@ Last paragraph.
 		doc := document.New(data, cmark.Render)
 		node, _, err := doc.Parse()
 		require.NoError(t, err)
-		cells := toCells(node, data)
+		cells := toCells(node, data, false)
 		assert.Len(t, cells, 3)
 		return cells
 	}
@@ -271,7 +297,7 @@ func Test_serializeCells_nestedCode(t *testing.T) {
 	doc := document.New(data, cmark.Render)
 	node, _, err := doc.Parse()
 	require.NoError(t, err)
-	cells := toCells(node, data)
+	cells := toCells(node, data, false)
 	assert.Equal(
 		t,
 		`# Development
@@ -299,7 +325,7 @@ func Test_serializeCells_attributes(t *testing.T) {
 	doc := document.New(data, cmark.Render)
 	node, _, err := doc.Parse()
 	require.NoError(t, err)
-	cells := toCells(node, data)
+	cells := toCells(node, data, false)
 	assert.Equal(t, string(data), string(serializeCells(cells)))
 }
 
@@ -309,7 +335,7 @@ func Test_serializeCells_privateFields(t *testing.T) {
 	node, _, err := doc.Parse()
 	require.NoError(t, err)
 
-	cells := toCells(node, data)
+	cells := toCells(node, data, false)
 	// Add private fields whcih will be filtered out durign serialization.
 	cells[0].Metadata["_private"] = "private"
 	cells[0].Metadata["runme.dev/internal"] = "internal"
@@ -317,6 +343,33 @@ func Test_serializeCells_privateFields(t *testing.T) {
 	assert.Equal(t, string(data), string(serializeCells(cells)))
 }
 
+func Test_serializeCells_quotedAttributes(t *testing.T) {
+	data := []byte("```sh { name=deploy args=[\"-v\", \"--force\"] description=\"push \\\"main\\\" to prod\" interactive=true }\necho 1\n```\n")
+	doc := document.New(data, cmark.Render)
+	node, _, err := doc.Parse()
+	require.NoError(t, err)
+
+	cells := toCells(node, data, false)
+	assert.Equal(t, `push "main" to prod`, cells[0].Metadata["description"])
+	assert.Equal(t, `["-v", "--force"]`, cells[0].Metadata["args"])
+	assert.Equal(t, string(data), string(serializeCells(cells)))
+}
+
+func Test_serializeCells_repeatedAttributes(t *testing.T) {
+	data := []byte("```sh { name=echo tag=a tag=\"b c\" }\necho 1\n```\n")
+	doc := document.New(data, cmark.Render)
+	node, _, err := doc.Parse()
+	require.NoError(t, err)
+
+	cells := toCells(node, data, false)
+	assert.Equal(t, `["a","b c"]`, cells[0].Metadata["tag"])
+	assert.Equal(t, string(data), string(serializeCells(cells)))
+
+	// A value which is no longer an array is written as a single attribute.
+	cells[0].Metadata["tag"] = "d"
+	assert.Equal(t, "```sh { name=echo tag=d }\necho 1\n```\n", string(serializeCells(cells)))
+}
+
 func Test_serializeCells_UnsupportedLang(t *testing.T) {
 	data := []byte(`## Non-Supported Languages
 
@@ -328,10 +381,23 @@ def hello():
 	doc := document.New(data, cmark.Render)
 	node, _, err := doc.Parse()
 	require.NoError(t, err)
-	cells := toCells(node, data)
+	cells := toCells(node, data, false)
 	assert.Equal(t, string(data), string(serializeCells(cells)))
 }
 
+func Test_serializeCells_DetectedLang(t *testing.T) {
+	data := []byte("```\necho 1\n```\n\n```\nls\n```\n")
+	doc := document.New(data, cmark.Render)
+	node, _, err := doc.Parse()
+	require.NoError(t, err)
+	cells := toCells(node, data, false)
+	require.Len(t, cells, 2)
+
+	// The detected language is not written unless it is changed.
+	cells[1].LanguageID = "bash"
+	assert.Equal(t, "```\necho 1\n```\n\n```bash\nls\n```\n", string(serializeCells(cells)))
+}
+
 func Test_serializeFencedCodeAttributes(t *testing.T) {
 	t.Run("NoMetadata", func(t *testing.T) {
 		var buf bytes.Buffer
@@ -0,0 +1,187 @@
+package editor
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"github.com/stateful/runme/internal/document"
+)
+
+// TextEdit replaces the text in Range of a source with NewText.
+type TextEdit struct {
+	Range   document.Range `json:"range"`
+	NewText string         `json:"newText"`
+}
+
+// EditCells deserializes the source, applies fn to the notebook and
+// returns the single edit turning the source into the serialized result.
+// It returns nil if the source does not change.
+//
+// As unchanged cells are written as they were, the edit covers only
+// the changed cells and the blank lines between them, unless cells
+// were nested in lists or block quotes, which are always flattened.
+func EditCells(source []byte, fn func(*Notebook) error) (*TextEdit, error) {
+	notebook, err := Deserialize(source)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to deserialize")
+	}
+	if err := fn(notebook); err != nil {
+		return nil, err
+	}
+	result, err := Serialize(notebook)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize")
+	}
+	return diffText(source, result), nil
+}
+
+// diffText returns the edit replacing the range between
+// the common prefix and suffix of a and b.
+func diffText(a, b []byte) *TextEdit {
+	if bytes.Equal(a, b) {
+		return nil
+	}
+
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+
+	prefix := 0
+	for prefix < n && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < n-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	// Do not split multi-byte characters.
+	for prefix > 0 && prefix < len(a) && !utf8.RuneStart(a[prefix]) {
+		prefix--
+	}
+	for suffix > 0 && !utf8.RuneStart(a[len(a)-suffix]) {
+		suffix--
+	}
+
+	return &TextEdit{
+		Range: document.Range{
+			Start: document.PositionAt(a, prefix),
+			End:   document.PositionAt(a, len(a)-suffix),
+		},
+		NewText: string(b[prefix : len(b)-suffix]),
+	}
+}
+
+func (n *Notebook) checkIndex(index int) error {
+	if index < 0 || index >= len(n.Cells) {
+		return errors.Errorf("cell index %d out of range [0, %d)", index, len(n.Cells))
+	}
+	return nil
+}
+
+// InsertCell inserts the cell at the index. The index
+// can be equal to the number of cells to append it.
+func (n *Notebook) InsertCell(index int, cell *Cell) error {
+	if index != len(n.Cells) {
+		if err := n.checkIndex(index); err != nil {
+			return err
+		}
+	}
+	n.Cells = append(n.Cells[:index], append([]*Cell{cell}, n.Cells[index:]...)...)
+	return nil
+}
+
+// UpdateCell replaces the cell at the index. Cells which differ
+// from the deserialized ones are written in the canonical format.
+func (n *Notebook) UpdateCell(index int, cell *Cell) error {
+	if err := n.checkIndex(index); err != nil {
+		return err
+	}
+	n.Cells[index] = cell
+	return nil
+}
+
+func (n *Notebook) DeleteCell(index int) error {
+	if err := n.checkIndex(index); err != nil {
+		return err
+	}
+	n.Cells = append(n.Cells[:index], n.Cells[index+1:]...)
+	return nil
+}
+
+// MoveCell moves the cell at the index from, so that
+// it ends up at the index to.
+func (n *Notebook) MoveCell(from, to int) error {
+	if err := n.checkIndex(from); err != nil {
+		return err
+	}
+	if err := n.checkIndex(to); err != nil {
+		return err
+	}
+	cell := n.Cells[from]
+	n.Cells = append(n.Cells[:from], n.Cells[from+1:]...)
+	n.Cells = append(n.Cells[:to], append([]*Cell{cell}, n.Cells[to:]...)...)
+	return nil
+}
+
+// SplitCell splits the value of the cell at the index at the byte
+// offset into two cells. The first one keeps the attributes and outputs.
+// The second one has the same kind and language, and the attributes
+// except for the ID and name, which must be unique.
+func (n *Notebook) SplitCell(index, offset int) error {
+	if err := n.checkIndex(index); err != nil {
+		return err
+	}
+	cell := n.Cells[index]
+	if offset <= 0 || offset >= len(cell.Value) || !utf8.RuneStart(cell.Value[offset]) {
+		return errors.Errorf("invalid offset %d to split cell %d", offset, index)
+	}
+
+	second := &Cell{
+		Kind:       cell.Kind,
+		Value:      strings.TrimLeft(cell.Value[offset:], "\r\n"),
+		LanguageID: cell.LanguageID,
+		Metadata:   publicAttributes(cell.Metadata),
+	}
+	delete(second.Metadata, "id")
+	delete(second.Metadata, "name")
+
+	cell.Value = strings.TrimRight(cell.Value[:offset], "\r\n")
+
+	n.Cells = append(n.Cells[:index+1], append([]*Cell{second}, n.Cells[index+1:]...)...)
+	return nil
+}
+
+// MergeCells merges the cell following the index into the cell at the
+// index. Both cells must be of the same kind. The merged cell keeps the
+// language and attributes of the first cell and the outputs of both.
+func (n *Notebook) MergeCells(index int) error {
+	if err := n.checkIndex(index); err != nil {
+		return err
+	}
+	if err := n.checkIndex(index + 1); err != nil {
+		return errors.Errorf("no cell to merge after cell %d", index)
+	}
+	first, second := n.Cells[index], n.Cells[index+1]
+	if first.Kind != second.Kind {
+		return errors.Errorf("cannot merge cells %d and %d of different kinds", index, index+1)
+	}
+
+	sep := "\n"
+	if first.Kind == MarkupKind {
+		sep = "\n\n"
+	}
+
+	n.Cells[index] = &Cell{
+		Kind:       first.Kind,
+		Value:      first.Value + sep + second.Value,
+		LanguageID: first.LanguageID,
+		Metadata:   first.Metadata,
+		Outputs:    append(append([]*CellOutput(nil), first.Outputs...), second.Outputs...),
+	}
+	n.Cells = append(n.Cells[:index+1], n.Cells[index+2:]...)
+	return nil
+}
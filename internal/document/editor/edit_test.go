@@ -0,0 +1,117 @@
+package editor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditCells(t *testing.T) {
+	source := "# Title\n\n\nIntro  with *emphasis*.\n\n```sh { name=first }\necho 1\necho 2\n```\n\n```sh {name=second}\necho 3\n```\n"
+
+	apply := func(t *testing.T, edit *TextEdit) string {
+		t.Helper()
+		require.NotNil(t, edit)
+		return source[:edit.Range.Start.Offset] + edit.NewText + source[edit.Range.End.Offset:]
+	}
+
+	t.Run("Insert", func(t *testing.T) {
+		edit, err := EditCells([]byte(source), func(n *Notebook) error {
+			return n.InsertCell(3, &Cell{Kind: MarkupKind, Value: "Between."})
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Between.\n\n", edit.NewText)
+		assert.Equal(t, 11, edit.Range.Start.Line)
+		assert.Equal(t, edit.Range.Start, edit.Range.End)
+		assert.Equal(t, "# Title\n\n\nIntro  with *emphasis*.\n\n```sh { name=first }\necho 1\necho 2\n```\n\nBetween.\n\n```sh {name=second}\necho 3\n```\n", apply(t, edit))
+
+		edit, err = EditCells([]byte(source), func(n *Notebook) error {
+			return n.InsertCell(4, &Cell{Kind: MarkupKind, Value: "End."})
+		})
+		require.NoError(t, err)
+		assert.Equal(t, source+"\nEnd.\n", apply(t, edit))
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		edit, err := EditCells([]byte(source), func(n *Notebook) error {
+			cell := *n.Cells[2]
+			cell.Value = "echo 1\necho two"
+			return n.UpdateCell(2, &cell)
+		})
+		require.NoError(t, err)
+		// Unchanged cells keep their formatting.
+		assert.Equal(t, "# Title\n\n\nIntro  with *emphasis*.\n\n```sh { name=first }\necho 1\necho two\n```\n\n```sh {name=second}\necho 3\n```\n", apply(t, edit))
+		assert.Equal(t, "two", edit.NewText)
+		assert.Equal(t, 8, edit.Range.Start.Line)
+		assert.Equal(t, 6, edit.Range.Start.Column)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		edit, err := EditCells([]byte(source), func(n *Notebook) error {
+			return n.DeleteCell(1)
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "# Title\n\n\n```sh { name=first }\necho 1\necho 2\n```\n\n```sh {name=second}\necho 3\n```\n", apply(t, edit))
+	})
+
+	t.Run("Move", func(t *testing.T) {
+		edit, err := EditCells([]byte(source), func(n *Notebook) error {
+			return n.MoveCell(3, 2)
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "# Title\n\n\nIntro  with *emphasis*.\n\n```sh {name=second}\necho 3\n```\n\n```sh { name=first }\necho 1\necho 2\n```\n", apply(t, edit))
+	})
+
+	t.Run("Split", func(t *testing.T) {
+		edit, err := EditCells([]byte(source), func(n *Notebook) error {
+			return n.SplitCell(2, len("echo 1\n"))
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "# Title\n\n\nIntro  with *emphasis*.\n\n```sh { name=first }\necho 1\n```\n\n```sh\necho 2\n```\n\n```sh {name=second}\necho 3\n```\n", apply(t, edit))
+
+		_, err = EditCells([]byte(source), func(n *Notebook) error {
+			return n.SplitCell(2, 0)
+		})
+		assert.EqualError(t, err, "invalid offset 0 to split cell 2")
+	})
+
+	t.Run("Merge", func(t *testing.T) {
+		edit, err := EditCells([]byte(source), func(n *Notebook) error {
+			return n.MergeCells(2)
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "# Title\n\n\nIntro  with *emphasis*.\n\n```sh { name=first }\necho 1\necho 2\necho 3\n```\n", apply(t, edit))
+
+		_, err = EditCells([]byte(source), func(n *Notebook) error {
+			return n.MergeCells(1)
+		})
+		assert.EqualError(t, err, "cannot merge cells 1 and 2 of different kinds")
+
+		_, err = EditCells([]byte(source), func(n *Notebook) error {
+			return n.MergeCells(3)
+		})
+		assert.EqualError(t, err, "no cell to merge after cell 3")
+	})
+
+	t.Run("Unchanged", func(t *testing.T) {
+		edit, err := EditCells([]byte(source), func(n *Notebook) error {
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Nil(t, edit)
+
+		_, err = EditCells([]byte(source), func(n *Notebook) error {
+			return n.DeleteCell(4)
+		})
+		assert.EqualError(t, err, "cell index 4 out of range [0, 4)")
+	})
+}
+
+func Test_diffText(t *testing.T) {
+	// "ó" and "ö" share the first byte.
+	edit := diffText([]byte("zażółć"), []byte("zażöłć"))
+	assert.Equal(t, "ö", edit.NewText)
+	assert.Equal(t, 4, edit.Range.Start.Offset)
+	assert.Equal(t, 6, edit.Range.End.Offset)
+}
@@ -9,28 +9,50 @@ import (
 
 const FrontmatterKey = "runme.dev/frontmatter"
 
+// Deserialize converts the document to a notebook. Output blocks
+// are kept as markup cells so that they are serialized unchanged.
 func Deserialize(data []byte) (*Notebook, error) {
+	return deserialize(data, false)
+}
+
+// DeserializeWithOutputs is like Deserialize, but output blocks
+// following code blocks become outputs of their cells.
+func DeserializeWithOutputs(data []byte) (*Notebook, error) {
+	return deserialize(data, true)
+}
+
+func deserialize(data []byte, includeOutputs bool) (*Notebook, error) {
 	sections, err := document.ParseSections(data)
 	if err != nil {
 		return nil, err
 	}
 
 	// Deserialize content to cells.
-	doc := document.New(sections.Content, cmark.Render)
+	doc := document.New(sections.Content, cmark.NewRenderer(sections.Content)).WithBase(sections.ContentStart)
 	node, _, err := doc.Parse()
 	if err != nil {
 		return nil, err
 	}
 
 	notebook := &Notebook{
-		Cells: toCells(node, data),
+		Cells: toCells(node, data, includeOutputs),
 	}
+	if err := doc.Err(); err != nil {
+		return nil, err
+	}
+	attachSources(notebook.Cells, node, data)
 
 	// If Front Matter exists, store it in Notebook's metadata.
 	if len(sections.FrontMatter) > 0 {
 		notebook.Metadata = map[string]string{
 			FrontmatterKey: string(sections.FrontMatter),
 		}
+
+		// Invalid front matter should not prevent editing the document,
+		// hence the error is ignored and the typed config is not set.
+		if fm, err := document.ParseFrontmatter(sections.FrontMatter); err == nil {
+			notebook.Frontmatter = fm
+		}
 	}
 
 	return notebook, nil
@@ -1,6 +1,7 @@
 package editor
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -10,6 +11,7 @@ import (
 func TestEditor(t *testing.T) {
 	notebook, err := Deserialize(testDataNested)
 	require.NoError(t, err)
+	notebook.StripSources()
 	result, err := Serialize(notebook)
 	require.NoError(t, err)
 	assert.Equal(
@@ -19,6 +21,33 @@ func TestEditor(t *testing.T) {
 	)
 }
 
+func TestEditor_Lossless(t *testing.T) {
+	notebook, err := Deserialize(testDataNested)
+	require.NoError(t, err)
+	result, err := Serialize(notebook)
+	require.NoError(t, err)
+	// Top-level blocks are written as they were,
+	// nested ones are flattened.
+	assert.Equal(
+		t,
+		strings.Replace(string(testDataNestedFlattened), "```sh { name=echo first= second=2 }", "```sh {name=echo first= second=2}", 1),
+		string(result),
+	)
+
+	data := []byte("Title\r\n=====\r\n\r\n*   Item\r\n\r\n\r\n[link]: https://runme.dev\r\n")
+	notebook, err = Deserialize(data)
+	require.NoError(t, err)
+	result, err = Serialize(notebook)
+	require.NoError(t, err)
+	assert.Equal(t, string(data), string(result))
+
+	// Removing a cell keeps the rest unchanged.
+	notebook.Cells = notebook.Cells[:1]
+	result, err = Serialize(notebook)
+	require.NoError(t, err)
+	assert.Equal(t, "Title\r\n=====\r\n", string(result))
+}
+
 func TestEditor_List(t *testing.T) {
 	data := []byte(`1. Item 1
 2. Item 2
@@ -50,6 +79,29 @@ func TestEditor_List(t *testing.T) {
 	)
 }
 
+func TestEditor_GFM(t *testing.T) {
+	data := []byte(`# Tasks[^1]
+
+| Task  | Done |
+| ----- | :--: |
+| Build |  ✓   |
+
+- [x] ~~Tag~~ the release
+- [ ] Publish notes
+
+` + "```sh" + `
+echo 1
+` + "```" + `
+
+[^1]: Tracked in the issue tracker.
+`)
+	notebook, err := Deserialize(data)
+	require.NoError(t, err)
+	result, err := Serialize(notebook)
+	require.NoError(t, err)
+	assert.Equal(t, string(data), string(result))
+}
+
 func TestEditor_CodeBlock(t *testing.T) {
 	t.Run("ProvideGeneratedName", func(t *testing.T) {
 		data := []byte("```sh\necho 1\n```\n")
@@ -113,3 +165,37 @@ A paragraph
 		string(result),
 	)
 }
+
+func TestEditor_FrontMatterTyped(t *testing.T) {
+	data := []byte(`---
+shell: bash
+cwd: ./scripts
+skipPrompts: true
+---
+
+# Example
+`)
+	notebook, err := Deserialize(data)
+	require.NoError(t, err)
+	require.NotNil(t, notebook.Frontmatter)
+	assert.Equal(t, "bash", notebook.Frontmatter.Shell)
+	assert.Equal(t, "./scripts", notebook.Frontmatter.Cwd)
+	assert.True(t, notebook.Frontmatter.SkipPrompts)
+
+	result, err := Serialize(notebook)
+	require.NoError(t, err)
+	assert.Equal(t, string(data), string(result))
+}
+
+func BenchmarkDeserialize(b *testing.B) {
+	section := "## Section\n\nSome *prose* with a [link](https://runme.dev).\n\n- Item\n- Item with **bold** text\n\n```sh\necho 1\nls -la\n```\n\n"
+	data := []byte(strings.Repeat(section, (4<<20)/len(section)))
+	b.SetBytes(int64(len(data)))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := Deserialize(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
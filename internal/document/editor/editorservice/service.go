@@ -2,7 +2,10 @@ package editorservice
 
 import (
 	"context"
+	"time"
 
+	"github.com/pkg/errors"
+	"github.com/stateful/runme/internal/document"
 	"github.com/stateful/runme/internal/document/editor"
 	parserv1 "github.com/stateful/runme/internal/gen/proto/go/runme/parser/v1"
 	"go.uber.org/zap"
@@ -22,12 +25,33 @@ func NewParserServiceServer(logger *zap.Logger) parserv1.ParserServiceServer {
 func (s *parserServiceServer) Deserialize(_ context.Context, req *parserv1.DeserializeRequest) (*parserv1.DeserializeResponse, error) {
 	s.logger.Info("Deserialize", zap.ByteString("source", req.Source[:min(len(req.Source), 64)]))
 
-	notebook, err := editor.Deserialize(req.Source)
+	source := req.Source
+	if req.AssignIds {
+		var err error
+		source, err = document.InsertIDs(source)
+		if err != nil {
+			s.logger.Info("failed to insert IDs", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	deserialize := editor.Deserialize
+	if req.IncludeOutputs {
+		deserialize = editor.DeserializeWithOutputs
+	}
+
+	notebook, err := deserialize(source)
 	if err != nil {
 		s.logger.Info("failed to call Deserialize", zap.Error(err))
 		return nil, err
 	}
 
+	return &parserv1.DeserializeResponse{
+		Notebook: toParserv1Notebook(notebook),
+	}, nil
+}
+
+func toParserv1Notebook(notebook *editor.Notebook) *parserv1.Notebook {
 	cells := make([]*parserv1.Cell, 0, len(notebook.Cells))
 	for _, cell := range notebook.Cells {
 		cells = append(cells, &parserv1.Cell{
@@ -35,33 +59,135 @@ func (s *parserServiceServer) Deserialize(_ context.Context, req *parserv1.Deser
 			Value:      cell.Value,
 			LanguageId: cell.LanguageID,
 			Metadata:   cell.Metadata,
+			TextRange:  toParserv1TextRange(cell.TextRange),
+			Outputs:    toParserv1CellOutputs(cell.Outputs),
 		})
 	}
 
-	return &parserv1.DeserializeResponse{
-		Notebook: &parserv1.Notebook{
-			Cells:    cells,
-			Metadata: notebook.Metadata,
-		},
-	}, nil
+	return &parserv1.Notebook{
+		Cells:       cells,
+		Metadata:    notebook.Metadata,
+		Frontmatter: toParserv1Frontmatter(notebook.Frontmatter),
+	}
+}
+
+func fromParserv1Notebook(notebook *parserv1.Notebook) *editor.Notebook {
+	cells := make([]*editor.Cell, 0, len(notebook.Cells))
+	for _, cell := range notebook.Cells {
+		cells = append(cells, fromParserv1Cell(cell))
+	}
+
+	return &editor.Notebook{
+		Cells:    cells,
+		Metadata: notebook.Metadata,
+	}
+}
+
+func fromParserv1Cell(cell *parserv1.Cell) *editor.Cell {
+	return &editor.Cell{
+		Kind:       editor.CellKind(cell.Kind),
+		Value:      cell.Value,
+		LanguageID: cell.LanguageId,
+		Metadata:   cell.Metadata,
+		Outputs:    fromParserv1CellOutputs(cell.Outputs),
+	}
+}
+
+func toParserv1CellOutputs(outputs []*editor.CellOutput) []*parserv1.CellOutput {
+	result := make([]*parserv1.CellOutput, 0, len(outputs))
+	for _, output := range outputs {
+		result = append(result, &parserv1.CellOutput{
+			Mime:      output.MIME,
+			Stdout:    output.Stdout,
+			Stderr:    output.Stderr,
+			ExitCode:  int32(output.ExitCode),
+			StartTime: formatTime(output.StartTime),
+			EndTime:   formatTime(output.EndTime),
+		})
+	}
+	return result
+}
+
+func fromParserv1CellOutputs(outputs []*parserv1.CellOutput) []*editor.CellOutput {
+	var result []*editor.CellOutput
+	for _, output := range outputs {
+		result = append(result, &editor.CellOutput{
+			MIME:      output.Mime,
+			Stdout:    output.Stdout,
+			Stderr:    output.Stderr,
+			ExitCode:  int(output.ExitCode),
+			StartTime: parseTime(output.StartTime),
+			EndTime:   parseTime(output.EndTime),
+		})
+	}
+	return result
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+// parseTime returns the zero time if s is empty or malformed.
+func parseTime(s string) time.Time {
+	t, _ := time.Parse(time.RFC3339Nano, s)
+	return t
+}
+
+func toParserv1Frontmatter(fm *document.Frontmatter) *parserv1.Frontmatter {
+	if fm == nil {
+		return nil
+	}
+	return &parserv1.Frontmatter{
+		Shell:       fm.Shell,
+		Cwd:         fm.Cwd,
+		Env:         fm.Env,
+		SkipPrompts: fm.SkipPrompts,
+		Tools:       fm.Tools,
+	}
+}
+
+func toParserv1TextRange(r *document.Range) *parserv1.TextRange {
+	if r == nil {
+		return nil
+	}
+	return &parserv1.TextRange{
+		Start: toParserv1Position(r.Start),
+		End:   toParserv1Position(r.End),
+	}
+}
+
+func toParserv1TextEdit(edit *editor.TextEdit) *parserv1.TextEdit {
+	if edit == nil {
+		return nil
+	}
+	return &parserv1.TextEdit{
+		Range:   toParserv1TextRange(&edit.Range),
+		NewText: edit.NewText,
+	}
+}
+
+func toParserv1Position(p document.Position) *parserv1.Position {
+	return &parserv1.Position{
+		Line:   uint32(p.Line),
+		Column: uint32(p.Column),
+		Offset: uint32(p.Offset),
+	}
 }
 
 func (s *parserServiceServer) Serialize(_ context.Context, req *parserv1.SerializeRequest) (*parserv1.SerializeResponse, error) {
 	s.logger.Info("Serialize")
 
-	cells := make([]*editor.Cell, 0, len(req.Notebook.Cells))
-	for _, cell := range req.Notebook.Cells {
-		cells = append(cells, &editor.Cell{
-			Kind:       editor.CellKind(cell.Kind),
-			Value:      cell.Value,
-			LanguageID: cell.LanguageId,
-			Metadata:   cell.Metadata,
-		})
+	notebook := fromParserv1Notebook(req.Notebook)
+
+	// Outputs are persisted only on request.
+	if !req.IncludeOutputs {
+		notebook.StripOutputs()
 	}
-	data, err := editor.Serialize(&editor.Notebook{
-		Cells:    cells,
-		Metadata: req.Notebook.Metadata,
-	})
+
+	data, err := editor.Serialize(notebook)
 	if err != nil {
 		s.logger.Info("failed to call Serialize", zap.Error(err))
 		return nil, err
@@ -69,6 +195,114 @@ func (s *parserServiceServer) Serialize(_ context.Context, req *parserv1.Seriali
 	return &parserv1.SerializeResponse{Result: data}, nil
 }
 
+func (s *parserServiceServer) FromJupyter(_ context.Context, req *parserv1.FromJupyterRequest) (*parserv1.FromJupyterResponse, error) {
+	s.logger.Info("FromJupyter")
+
+	notebook, err := editor.FromJupyter(req.Source, req.IncludeOutputs)
+	if err != nil {
+		s.logger.Info("failed to call FromJupyter", zap.Error(err))
+		return nil, err
+	}
+	return &parserv1.FromJupyterResponse{Notebook: toParserv1Notebook(notebook)}, nil
+}
+
+func (s *parserServiceServer) ToJupyter(_ context.Context, req *parserv1.ToJupyterRequest) (*parserv1.ToJupyterResponse, error) {
+	s.logger.Info("ToJupyter")
+
+	data, err := editor.ToJupyter(fromParserv1Notebook(req.Notebook))
+	if err != nil {
+		s.logger.Info("failed to call ToJupyter", zap.Error(err))
+		return nil, err
+	}
+	return &parserv1.ToJupyterResponse{Result: data}, nil
+}
+
+func (s *parserServiceServer) InsertCell(_ context.Context, req *parserv1.InsertCellRequest) (*parserv1.InsertCellResponse, error) {
+	s.logger.Info("InsertCell", zap.Uint32("index", req.Index))
+
+	if req.Cell == nil {
+		return nil, errors.New("cell is required")
+	}
+
+	edit, err := editor.EditCells(req.Source, func(n *editor.Notebook) error {
+		return n.InsertCell(int(req.Index), fromParserv1Cell(req.Cell))
+	})
+	if err != nil {
+		s.logger.Info("failed to insert cell", zap.Error(err))
+		return nil, err
+	}
+	return &parserv1.InsertCellResponse{Edit: toParserv1TextEdit(edit)}, nil
+}
+
+func (s *parserServiceServer) UpdateCell(_ context.Context, req *parserv1.UpdateCellRequest) (*parserv1.UpdateCellResponse, error) {
+	s.logger.Info("UpdateCell", zap.Uint32("index", req.Index))
+
+	if req.Cell == nil {
+		return nil, errors.New("cell is required")
+	}
+
+	edit, err := editor.EditCells(req.Source, func(n *editor.Notebook) error {
+		return n.UpdateCell(int(req.Index), fromParserv1Cell(req.Cell))
+	})
+	if err != nil {
+		s.logger.Info("failed to update cell", zap.Error(err))
+		return nil, err
+	}
+	return &parserv1.UpdateCellResponse{Edit: toParserv1TextEdit(edit)}, nil
+}
+
+func (s *parserServiceServer) DeleteCell(_ context.Context, req *parserv1.DeleteCellRequest) (*parserv1.DeleteCellResponse, error) {
+	s.logger.Info("DeleteCell", zap.Uint32("index", req.Index))
+
+	edit, err := editor.EditCells(req.Source, func(n *editor.Notebook) error {
+		return n.DeleteCell(int(req.Index))
+	})
+	if err != nil {
+		s.logger.Info("failed to delete cell", zap.Error(err))
+		return nil, err
+	}
+	return &parserv1.DeleteCellResponse{Edit: toParserv1TextEdit(edit)}, nil
+}
+
+func (s *parserServiceServer) MoveCell(_ context.Context, req *parserv1.MoveCellRequest) (*parserv1.MoveCellResponse, error) {
+	s.logger.Info("MoveCell", zap.Uint32("from", req.From), zap.Uint32("to", req.To))
+
+	edit, err := editor.EditCells(req.Source, func(n *editor.Notebook) error {
+		return n.MoveCell(int(req.From), int(req.To))
+	})
+	if err != nil {
+		s.logger.Info("failed to move cell", zap.Error(err))
+		return nil, err
+	}
+	return &parserv1.MoveCellResponse{Edit: toParserv1TextEdit(edit)}, nil
+}
+
+func (s *parserServiceServer) SplitCell(_ context.Context, req *parserv1.SplitCellRequest) (*parserv1.SplitCellResponse, error) {
+	s.logger.Info("SplitCell", zap.Uint32("index", req.Index), zap.Uint32("offset", req.Offset))
+
+	edit, err := editor.EditCells(req.Source, func(n *editor.Notebook) error {
+		return n.SplitCell(int(req.Index), int(req.Offset))
+	})
+	if err != nil {
+		s.logger.Info("failed to split cell", zap.Error(err))
+		return nil, err
+	}
+	return &parserv1.SplitCellResponse{Edit: toParserv1TextEdit(edit)}, nil
+}
+
+func (s *parserServiceServer) MergeCells(_ context.Context, req *parserv1.MergeCellsRequest) (*parserv1.MergeCellsResponse, error) {
+	s.logger.Info("MergeCells", zap.Uint32("index", req.Index))
+
+	edit, err := editor.EditCells(req.Source, func(n *editor.Notebook) error {
+		return n.MergeCells(int(req.Index))
+	})
+	if err != nil {
+		s.logger.Info("failed to merge cells", zap.Error(err))
+		return nil, err
+	}
+	return &parserv1.MergeCellsResponse{Edit: toParserv1TextEdit(edit)}, nil
+}
+
 func min[T constraints.Ordered](a, b T) T {
 	if a < b {
 		return a
@@ -41,12 +41,23 @@ func Test_parserServiceServer(t *testing.T) {
 		)
 		assert.NoError(t, err)
 		assert.Len(t, resp.Notebook.Cells, 2)
+
+		// Original sources are tested separately in "Lossless".
+		assert.Equal(t, "# Title\n\n", resp.Notebook.Cells[0].Metadata["runme.dev/source"])
+		for _, cell := range resp.Notebook.Cells {
+			cell.Metadata = nil
+		}
+
 		assert.True(
 			t,
 			proto.Equal(
 				&parserv1.Cell{
 					Kind:  parserv1.CellKind_CELL_KIND_MARKUP,
 					Value: "# Title",
+					TextRange: &parserv1.TextRange{
+						Start: &parserv1.Position{Line: 1, Column: 1, Offset: 0},
+						End:   &parserv1.Position{Line: 1, Column: 8, Offset: 7},
+					},
 				},
 				resp.Notebook.Cells[0],
 			),
@@ -57,12 +68,192 @@ func Test_parserServiceServer(t *testing.T) {
 				&parserv1.Cell{
 					Kind:  parserv1.CellKind_CELL_KIND_MARKUP,
 					Value: "Some content",
+					TextRange: &parserv1.TextRange{
+						Start: &parserv1.Position{Line: 3, Column: 1, Offset: 9},
+						End:   &parserv1.Position{Line: 3, Column: 13, Offset: 21},
+					},
 				},
 				resp.Notebook.Cells[1],
 			),
 		)
 	})
 
+	t.Run("Lossless", func(t *testing.T) {
+		source := "Title\n=====\n\n\n__Bold__ and _italic_.\n\n* Item\n* Item\n\n```sh {name=echo}\necho 1\n```\n"
+
+		dResp, err := client.Deserialize(
+			context.Background(),
+			&parserv1.DeserializeRequest{
+				Source: []byte(source),
+			},
+		)
+		assert.NoError(t, err)
+		require.Len(t, dResp.Notebook.Cells, 4)
+
+		sResp, err := client.Serialize(
+			context.Background(),
+			&parserv1.SerializeRequest{
+				Notebook: dResp.Notebook,
+			},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, source, string(sResp.Result))
+
+		// Only the edited cell is rendered again.
+		dResp.Notebook.Cells[1].Value = "**Bold** only."
+		sResp, err = client.Serialize(
+			context.Background(),
+			&parserv1.SerializeRequest{
+				Notebook: dResp.Notebook,
+			},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, "Title\n=====\n\n\n**Bold** only.\n\n* Item\n* Item\n\n```sh {name=echo}\necho 1\n```\n", string(sResp.Result))
+	})
+
+	t.Run("Outputs", func(t *testing.T) {
+		source := "```sh { name=hello }\necho hello\n```\n\n```output { exitCode=0 start=2023-01-02T15:04:05Z }\nhello\n```\n"
+
+		// Output blocks are kept as they are unless outputs are requested.
+		dResp, err := client.Deserialize(
+			context.Background(),
+			&parserv1.DeserializeRequest{
+				Source: []byte(source),
+			},
+		)
+		assert.NoError(t, err)
+		require.Len(t, dResp.Notebook.Cells, 2)
+		assert.Empty(t, dResp.Notebook.Cells[0].Outputs)
+
+		sResp, err := client.Serialize(
+			context.Background(),
+			&parserv1.SerializeRequest{
+				Notebook: dResp.Notebook,
+			},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, source, string(sResp.Result))
+
+		dResp, err = client.Deserialize(
+			context.Background(),
+			&parserv1.DeserializeRequest{
+				Source:         []byte(source),
+				IncludeOutputs: true,
+			},
+		)
+		assert.NoError(t, err)
+		require.Len(t, dResp.Notebook.Cells, 1)
+		require.Len(t, dResp.Notebook.Cells[0].Outputs, 1)
+		output := dResp.Notebook.Cells[0].Outputs[0]
+		assert.Equal(t, "hello\n", output.Stdout)
+		assert.Equal(t, "2023-01-02T15:04:05Z", output.StartTime)
+		assert.Equal(t, "", output.EndTime)
+
+		output.Stdout = "hello again\n"
+		output.ExitCode = 1
+
+		sResp, err = client.Serialize(
+			context.Background(),
+			&parserv1.SerializeRequest{
+				Notebook:       dResp.Notebook,
+				IncludeOutputs: true,
+			},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, "```sh { name=hello }\necho hello\n```\n\n```output { exitCode=1 start=2023-01-02T15:04:05Z }\nhello again\n```\n", string(sResp.Result))
+
+		// Outputs are dropped unless requested.
+		sResp, err = client.Serialize(
+			context.Background(),
+			&parserv1.SerializeRequest{
+				Notebook: dResp.Notebook,
+			},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, "```sh { name=hello }\necho hello\n```\n", string(sResp.Result))
+	})
+
+	t.Run("CellOperations", func(t *testing.T) {
+		source := []byte("# Title\n\n```sh {name=echo}\necho 1\n```\n")
+
+		iResp, err := client.InsertCell(
+			context.Background(),
+			&parserv1.InsertCellRequest{
+				Source: source,
+				Index:  1,
+				Cell:   &parserv1.Cell{Kind: parserv1.CellKind_CELL_KIND_MARKUP, Value: "Intro."},
+			},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "Intro.\n\n", iResp.Edit.NewText)
+		assert.EqualValues(t, 3, iResp.Edit.Range.Start.Line)
+		assert.EqualValues(t, 9, iResp.Edit.Range.End.Offset)
+
+		mResp, err := client.MoveCell(
+			context.Background(),
+			&parserv1.MoveCellRequest{Source: source, From: 1, To: 0},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "```sh {name=echo}\necho 1\n```\n\n# Title", mResp.Edit.NewText)
+
+		uResp, err := client.UpdateCell(
+			context.Background(),
+			&parserv1.UpdateCellRequest{
+				Source: source,
+				Index:  0,
+				Cell:   &parserv1.Cell{Kind: parserv1.CellKind_CELL_KIND_MARKUP, Value: "# Title"},
+			},
+		)
+		require.NoError(t, err)
+		assert.Nil(t, uResp.Edit)
+
+		_, err = client.DeleteCell(
+			context.Background(),
+			&parserv1.DeleteCellRequest{Source: source, Index: 2},
+		)
+		assert.ErrorContains(t, err, "cell index 2 out of range [0, 2)")
+	})
+
+	t.Run("Jupyter", func(t *testing.T) {
+		source := "# Title\n\n```sh { name=hello }\necho hello\n```\n"
+
+		dResp, err := client.Deserialize(
+			context.Background(),
+			&parserv1.DeserializeRequest{
+				Source: []byte(source),
+			},
+		)
+		require.NoError(t, err)
+
+		toResp, err := client.ToJupyter(
+			context.Background(),
+			&parserv1.ToJupyterRequest{
+				Notebook: dResp.Notebook,
+			},
+		)
+		require.NoError(t, err)
+		assert.Contains(t, string(toResp.Result), `"nbformat": 4`)
+
+		fromResp, err := client.FromJupyter(
+			context.Background(),
+			&parserv1.FromJupyterRequest{
+				Source: toResp.Result,
+			},
+		)
+		require.NoError(t, err)
+		require.Len(t, fromResp.Notebook.Cells, 2)
+		assert.Equal(t, "sh", fromResp.Notebook.Cells[1].LanguageId)
+
+		sResp, err := client.Serialize(
+			context.Background(),
+			&parserv1.SerializeRequest{
+				Notebook: fromResp.Notebook,
+			},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, source, string(sResp.Result))
+	})
+
 	t.Run("Frontmatter", func(t *testing.T) {
 		frontMatter := `---
 prop: value
@@ -84,6 +275,9 @@ Some content
 			frontMatter,
 			dResp.Notebook.Metadata[editor.FrontmatterKey],
 		)
+		// Ranges are relative to the source including front matter.
+		assert.EqualValues(t, 4, dResp.Notebook.Cells[0].TextRange.Start.Line)
+		assert.EqualValues(t, len(frontMatter)+1, dResp.Notebook.Cells[0].TextRange.Start.Offset)
 
 		sResp, err := client.Serialize(
 			context.Background(),
@@ -94,4 +288,28 @@ Some content
 		assert.NoError(t, err)
 		assert.Equal(t, frontMatter+"\n\n"+content, string(sResp.Result))
 	})
+	t.Run("AssignIDs", func(t *testing.T) {
+		source := "```sh { name=echo }\necho 1\n```\n\n```sh { id=existing }\necho 2\n```\n"
+
+		dResp, err := client.Deserialize(
+			context.Background(),
+			&parserv1.DeserializeRequest{
+				Source:    []byte(source),
+				AssignIds: true,
+			},
+		)
+		assert.NoError(t, err)
+		require.Len(t, dResp.Notebook.Cells, 2)
+		assert.NotEmpty(t, dResp.Notebook.Cells[0].Metadata["id"])
+		assert.Equal(t, "existing", dResp.Notebook.Cells[1].Metadata["id"])
+
+		sResp, err := client.Serialize(
+			context.Background(),
+			&parserv1.SerializeRequest{
+				Notebook: dResp.Notebook,
+			},
+		)
+		assert.NoError(t, err)
+		assert.Contains(t, string(sResp.Result), "```sh { name=echo id="+dResp.Notebook.Cells[0].Metadata["id"]+" }")
+	})
 }
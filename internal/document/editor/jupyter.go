@@ -0,0 +1,352 @@
+package editor
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Jupyter notebooks are converted according to the nbformat 4 schema.
+// https://nbformat.readthedocs.io/en/latest/format_description.html
+//
+// Code cells keep their attributes and language in the "runme" key of
+// the cell's metadata, so that a notebook converted to Jupyter and back
+// is unchanged. Cells of notebooks created in Jupyter, i.e. without this
+// key, get a language from a cell magic like "%%bash", VS Code cell
+// metadata, or the language of the notebook, in that order.
+
+const (
+	jupyterFormat      = 4
+	jupyterFormatMinor = 4
+)
+
+type jupyterNotebook struct {
+	Cells         []*jupyterCell          `json:"cells"`
+	Metadata      jupyterNotebookMetadata `json:"metadata"`
+	NBFormat      int                     `json:"nbformat"`
+	NBFormatMinor int                     `json:"nbformat_minor"`
+}
+
+type jupyterNotebookMetadata struct {
+	KernelSpec   *jupyterKernelSpec   `json:"kernelspec,omitempty"`
+	LanguageInfo *jupyterLanguageInfo `json:"language_info,omitempty"`
+	Runme        *jupyterRunme        `json:"runme,omitempty"`
+}
+
+type jupyterKernelSpec struct {
+	Language string `json:"language,omitempty"`
+}
+
+type jupyterLanguageInfo struct {
+	Name string `json:"name"`
+}
+
+// jupyterRunme is stored in the metadata of notebooks and cells.
+type jupyterRunme struct {
+	Frontmatter string            `json:"frontmatter,omitempty"`
+	LanguageID  string            `json:"languageId,omitempty"`
+	Attributes  map[string]string `json:"attributes,omitempty"`
+}
+
+type jupyterCellMetadata struct {
+	Runme  *jupyterRunme `json:"runme,omitempty"`
+	VSCode *struct {
+		LanguageID string `json:"languageId,omitempty"`
+	} `json:"vscode,omitempty"`
+}
+
+type jupyterCell struct {
+	CellType string              `json:"cell_type"`
+	Metadata jupyterCellMetadata `json:"metadata"`
+	Source   jupyterText         `json:"source"`
+	// Only for code cells.
+	ExecutionCount *int             `json:"execution_count,omitempty"`
+	Outputs        []*jupyterOutput `json:"outputs,omitempty"`
+}
+
+// MarshalJSON writes fields of code cells which are required,
+// even if they are empty.
+func (c *jupyterCell) MarshalJSON() ([]byte, error) {
+	type cell jupyterCell
+	if c.CellType != "code" {
+		return json.Marshal((*cell)(c))
+	}
+	outputs := c.Outputs
+	if outputs == nil {
+		outputs = []*jupyterOutput{}
+	}
+	return json.Marshal(struct {
+		*cell
+		ExecutionCount *int             `json:"execution_count"`
+		Outputs        []*jupyterOutput `json:"outputs"`
+	}{(*cell)(c), c.ExecutionCount, outputs})
+}
+
+type jupyterOutput struct {
+	OutputType string                 `json:"output_type"`
+	Name       string                 `json:"name,omitempty"`
+	Text       jupyterText            `json:"text,omitempty"`
+	Data       map[string]jupyterText `json:"data,omitempty"`
+	EName      string                 `json:"ename,omitempty"`
+	EValue     string                 `json:"evalue,omitempty"`
+}
+
+// fromJupyterOutputs merges outputs of a code cell into a single
+// output. Errors result in the exit code 1.
+func fromJupyterOutputs(outputs []*jupyterOutput) []*CellOutput {
+	if len(outputs) == 0 {
+		return nil
+	}
+	var result CellOutput
+	for _, o := range outputs {
+		switch o.OutputType {
+		case "stream":
+			if o.Name == outputStreamStderr {
+				result.Stderr += string(o.Text)
+			} else {
+				result.Stdout += string(o.Text)
+			}
+		case "execute_result", "display_data":
+			result.Stdout += string(o.Data["text/plain"])
+		case "error":
+			result.Stderr += o.EName + ": " + o.EValue + "\n"
+			result.ExitCode = 1
+		}
+	}
+	return []*CellOutput{&result}
+}
+
+// toJupyterOutputs converts outputs to stream outputs.
+func toJupyterOutputs(outputs []*CellOutput) []*jupyterOutput {
+	var result []*jupyterOutput
+	for _, o := range outputs {
+		if o.Stdout != "" {
+			result = append(result, &jupyterOutput{OutputType: "stream", Name: outputStreamStdout, Text: jupyterText(o.Stdout)})
+		}
+		if o.Stderr != "" {
+			result = append(result, &jupyterOutput{OutputType: "stream", Name: outputStreamStderr, Text: jupyterText(o.Stderr)})
+		}
+	}
+	return result
+}
+
+// jupyterText is a multi-line string, which is stored either
+// as a string or a list of lines.
+type jupyterText string
+
+func (t *jupyterText) UnmarshalJSON(data []byte) error {
+	var lines []string
+	if err := json.Unmarshal(data, &lines); err == nil {
+		*t = jupyterText(strings.Join(lines, ""))
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return errors.WithStack(err)
+	}
+	*t = jupyterText(s)
+	return nil
+}
+
+func (t jupyterText) MarshalJSON() ([]byte, error) {
+	lines := []string{}
+	if t != "" {
+		lines = strings.SplitAfter(string(t), "\n")
+		if lines[len(lines)-1] == "" {
+			lines = lines[:len(lines)-1]
+		}
+	}
+	return json.Marshal(lines)
+}
+
+// cellMagicLanguages are languages of IPython cell magics.
+var cellMagicLanguages = map[string]string{
+	"bash":       "bash",
+	"sh":         "sh",
+	"javascript": "javascript",
+	"js":         "javascript",
+	"perl":       "perl",
+	"python":     "python",
+	"python3":    "python",
+	"ruby":       "ruby",
+	"html":       "html",
+}
+
+// vscodeLanguageIDs map VS Code language identifiers
+// to code block languages and the other way around.
+var vscodeLanguageIDs = map[string]string{
+	"shellscript": "sh",
+}
+
+// FromJupyter converts a Jupyter notebook. Text outputs of code cells
+// are included as their outputs, if includeOutputs is true.
+func FromJupyter(data []byte, includeOutputs bool) (*Notebook, error) {
+	var source jupyterNotebook
+	if err := json.Unmarshal(data, &source); err != nil {
+		return nil, errors.Wrap(err, "failed to decode Jupyter notebook")
+	}
+	if source.NBFormat != jupyterFormat {
+		return nil, errors.Errorf("unsupported Jupyter notebook format %d", source.NBFormat)
+	}
+
+	defaultLang := ""
+	if info := source.Metadata.LanguageInfo; info != nil {
+		defaultLang = info.Name
+	} else if spec := source.Metadata.KernelSpec; spec != nil {
+		defaultLang = spec.Language
+	}
+
+	notebook := &Notebook{}
+
+	if runme := source.Metadata.Runme; runme != nil && runme.Frontmatter != "" {
+		notebook.Metadata = map[string]string{
+			FrontmatterKey: runme.Frontmatter,
+		}
+	}
+
+	for _, cell := range source.Cells {
+		value := strings.TrimRight(string(cell.Source), "\n")
+
+		if cell.CellType != "code" {
+			// Raw cells are kept as they are.
+			notebook.Cells = append(notebook.Cells, &Cell{
+				Kind:  MarkupKind,
+				Value: value,
+			})
+			continue
+		}
+
+		lang := defaultLang
+		metadata := make(map[string]string)
+
+		if runme := cell.Metadata.Runme; runme != nil {
+			lang = runme.LanguageID
+			for k, v := range runme.Attributes {
+				metadata[k] = v
+			}
+		} else {
+			if vscode := cell.Metadata.VSCode; vscode != nil && vscode.LanguageID != "" {
+				lang = vscode.LanguageID
+				if l, ok := vscodeLanguageIDs[lang]; ok {
+					lang = l
+				}
+			}
+			if first, rest, _ := strings.Cut(value, "\n"); strings.HasPrefix(first, "%%") {
+				if l, ok := cellMagicLanguages[strings.TrimSpace(first[2:])]; ok {
+					lang, value = l, rest
+				}
+			}
+		}
+
+		code := &Cell{
+			Kind:       CodeKind,
+			Value:      value,
+			LanguageID: lang,
+			Metadata:   metadata,
+		}
+		if includeOutputs {
+			code.Outputs = fromJupyterOutputs(cell.Outputs)
+		}
+		notebook.Cells = append(notebook.Cells, code)
+	}
+
+	return notebook, nil
+}
+
+// ToJupyter converts the notebook to a Jupyter notebook.
+// Outputs of code cells are written as stream outputs.
+func ToJupyter(notebook *Notebook) ([]byte, error) {
+	result := jupyterNotebook{
+		Cells:         []*jupyterCell{},
+		NBFormat:      jupyterFormat,
+		NBFormatMinor: jupyterFormatMinor,
+	}
+
+	if fm := notebook.Metadata[FrontmatterKey]; fm != "" {
+		result.Metadata.Runme = &jupyterRunme{Frontmatter: fm}
+	}
+
+	languages := make(map[string]int)
+
+	for _, cell := range notebook.Cells {
+		switch cell.Kind {
+		case CodeKind:
+			jcell := &jupyterCell{
+				CellType: "code",
+				Source:   jupyterText(cell.Value),
+				Outputs:  toJupyterOutputs(cell.Outputs),
+			}
+
+			// Detected languages are omitted, so that code blocks
+			// without a language stay without it.
+			jcell.Metadata.Runme = &jupyterRunme{
+				Attributes: publicAttributes(cell.Metadata),
+			}
+			if cell.LanguageID != cell.Metadata[prefixAttributeName(internalAttributePrefix, detectedLanguageAttribute)] {
+				jcell.Metadata.Runme.LanguageID = cell.LanguageID
+			}
+
+			if lang := cell.LanguageID; lang != "" {
+				languages[lang]++
+				for id, l := range vscodeLanguageIDs {
+					if l == lang {
+						lang = id
+					}
+				}
+				jcell.Metadata.VSCode = &struct {
+					LanguageID string `json:"languageId,omitempty"`
+				}{lang}
+			}
+
+			result.Cells = append(result.Cells, jcell)
+
+		case MarkupKind:
+			result.Cells = append(result.Cells, &jupyterCell{
+				CellType: "markdown",
+				Source:   jupyterText(cell.Value),
+			})
+		}
+	}
+
+	// The language of the notebook is the most common one.
+	if len(languages) > 0 {
+		names := make([]string, 0, len(languages))
+		for name := range languages {
+			names = append(names, name)
+		}
+		sort.Slice(names, func(i, j int) bool {
+			if languages[names[i]] != languages[names[j]] {
+				return languages[names[i]] > languages[names[j]]
+			}
+			return names[i] < names[j]
+		})
+		result.Metadata.LanguageInfo = &jupyterLanguageInfo{Name: names[0]}
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetIndent("", " ")
+	if err := enc.Encode(result); err != nil {
+		return nil, errors.Wrap(err, "failed to encode Jupyter notebook")
+	}
+	return buf.Bytes(), nil
+}
+
+// publicAttributes returns metadata without private and internal keys,
+// i.e. attributes which are serialized.
+func publicAttributes(metadata map[string]string) map[string]string {
+	var result map[string]string
+	for k, v := range metadata {
+		if k == "index" || strings.HasPrefix(k, privateAttributePrefix) || strings.HasPrefix(k, internalAttributePrefix) {
+			continue
+		}
+		if result == nil {
+			result = make(map[string]string)
+		}
+		result[k] = v
+	}
+	return result
+}
@@ -0,0 +1,138 @@
+package editor
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromJupyter(t *testing.T) {
+	data := []byte(`{
+ "cells": [
+  {"cell_type": "markdown", "metadata": {}, "source": ["# Title\n", "\n", "Intro."]},
+  {"cell_type": "code", "execution_count": 1, "metadata": {}, "source": "print(1)\n", "outputs": [
+   {"output_type": "stream", "name": "stdout", "text": ["1\n"]}
+  ]},
+  {"cell_type": "code", "execution_count": null, "metadata": {}, "source": ["%%bash\n", "echo 1"], "outputs": [
+   {"output_type": "execute_result", "data": {"text/plain": "1"}, "metadata": {}, "execution_count": 2}
+  ]},
+  {"cell_type": "code", "execution_count": null, "metadata": {"vscode": {"languageId": "shellscript"}}, "source": "ls", "outputs": [
+   {"output_type": "error", "ename": "Error", "evalue": "failed", "traceback": []}
+  ]},
+  {"cell_type": "raw", "metadata": {}, "source": "raw"}
+ ],
+ "metadata": {"language_info": {"name": "python"}},
+ "nbformat": 4,
+ "nbformat_minor": 5
+}`)
+
+	notebook, err := FromJupyter(data, false)
+	require.NoError(t, err)
+
+	type cell struct {
+		kind  CellKind
+		value string
+		lang  string
+	}
+	var cells []cell
+	for _, c := range notebook.Cells {
+		cells = append(cells, cell{c.Kind, c.Value, c.LanguageID})
+	}
+	assert.Equal(
+		t,
+		[]cell{
+			{MarkupKind, "# Title\n\nIntro.", ""},
+			{CodeKind, "print(1)", "python"},
+			{CodeKind, "echo 1", "bash"},
+			{CodeKind, "ls", "sh"},
+			{MarkupKind, "raw", ""},
+		},
+		cells,
+	)
+
+	notebook, err = FromJupyter(data, true)
+	require.NoError(t, err)
+	require.Len(t, notebook.Cells, 5)
+	assert.Equal(t, []*CellOutput{{Stdout: "1\n"}}, notebook.Cells[1].Outputs)
+	assert.Equal(t, []*CellOutput{{Stdout: "1"}}, notebook.Cells[2].Outputs)
+	assert.Equal(t, []*CellOutput{{Stderr: "Error: failed\n", ExitCode: 1}}, notebook.Cells[3].Outputs)
+
+	_, err = FromJupyter([]byte(`{"cells": [], "nbformat": 3}`), false)
+	assert.EqualError(t, err, "unsupported Jupyter notebook format 3")
+}
+
+func TestToJupyter(t *testing.T) {
+	data := []byte("---\nshell: bash\n---\n\n# Title\n\n```sh { name=hello }\necho hello\n```\n\n```\nls\n```\n\n```go\npackage main\n```\n\n```sh\necho 2\n```\n")
+
+	notebook, err := Deserialize(data)
+	require.NoError(t, err)
+
+	result, err := ToJupyter(notebook)
+	require.NoError(t, err)
+
+	var jnotebook map[string]any
+	require.NoError(t, json.Unmarshal(result, &jnotebook))
+	assert.EqualValues(t, 4, jnotebook["nbformat"])
+	assert.Equal(
+		t,
+		map[string]any{
+			"language_info": map[string]any{"name": "sh"},
+			"runme":         map[string]any{"frontmatter": "---\nshell: bash\n---"},
+		},
+		jnotebook["metadata"],
+	)
+
+	cells := jnotebook["cells"].([]any)
+	require.Len(t, cells, 5)
+	assert.Equal(
+		t,
+		map[string]any{
+			"cell_type":       "code",
+			"execution_count": nil,
+			"outputs":         []any{},
+			"source":          []any{"echo hello"},
+			"metadata": map[string]any{
+				"runme":  map[string]any{"languageId": "sh", "attributes": map[string]any{"name": "hello"}},
+				"vscode": map[string]any{"languageId": "shellscript"},
+			},
+		},
+		cells[1],
+	)
+
+	// Converting back results in the same document.
+	notebook, err = FromJupyter(result, false)
+	require.NoError(t, err)
+	back, err := Serialize(notebook)
+	require.NoError(t, err)
+	assert.Equal(t, string(data), string(back))
+}
+
+func TestToJupyter_Outputs(t *testing.T) {
+	notebook := &Notebook{
+		Cells: []*Cell{
+			{
+				Kind:       CodeKind,
+				Value:      "echo hello",
+				LanguageID: "sh",
+				Outputs:    []*CellOutput{{Stdout: "hello\n", Stderr: "warning\n", ExitCode: 1}},
+			},
+		},
+	}
+
+	result, err := ToJupyter(notebook)
+	require.NoError(t, err)
+
+	var jnotebook map[string]any
+	require.NoError(t, json.Unmarshal(result, &jnotebook))
+	cell := jnotebook["cells"].([]any)[0].(map[string]any)
+	assert.Equal(
+		t,
+		[]any{
+			map[string]any{"output_type": "stream", "name": "stdout", "text": []any{"hello\n"}},
+			map[string]any{"output_type": "stream", "name": "stderr", "text": []any{"warning\n"}},
+		},
+		cell["outputs"],
+	)
+}
@@ -0,0 +1,125 @@
+package editor
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stateful/runme/internal/document"
+)
+
+// CellOutput is a result of executing a code cell.
+//
+// Outputs are serialized as fenced code blocks in the language
+// document.OutputLanguage which follow the code block of the cell.
+// The first block of an output contains its stdout and attributes,
+// like the exit code; an optional second block with the "stream=stderr"
+// attribute contains its stderr.
+type CellOutput struct {
+	// MIME is the type of stdout. Empty means "text/plain".
+	MIME      string    `json:"mime,omitempty"`
+	Stdout    string    `json:"stdout,omitempty"`
+	Stderr    string    `json:"stderr,omitempty"`
+	ExitCode  int       `json:"exitCode"`
+	StartTime time.Time `json:"startTime,omitempty"`
+	EndTime   time.Time `json:"endTime,omitempty"`
+}
+
+const (
+	outputStreamStdout = "stdout"
+	outputStreamStderr = "stderr"
+)
+
+func serializeOutputs(buf *bytes.Buffer, outputs []*CellOutput) {
+	for _, output := range outputs {
+		attributes := map[string]string{
+			"exitCode": strconv.Itoa(output.ExitCode),
+		}
+		if output.MIME != "" {
+			attributes["mime"] = output.MIME
+		}
+		if !output.StartTime.IsZero() {
+			attributes["start"] = output.StartTime.Format(time.RFC3339Nano)
+		}
+		if !output.EndTime.IsZero() {
+			attributes["end"] = output.EndTime.Format(time.RFC3339Nano)
+		}
+
+		if output.Stdout != "" || output.Stderr == "" {
+			serializeOutputBlock(buf, output.Stdout, attributes)
+			attributes = map[string]string{}
+		}
+		if output.Stderr != "" {
+			attributes["stream"] = outputStreamStderr
+			serializeOutputBlock(buf, output.Stderr, attributes)
+		}
+	}
+}
+
+func serializeOutputBlock(buf *bytes.Buffer, value string, attributes map[string]string) {
+	value = strings.TrimSuffix(value, "\n")
+
+	ticksCount := longestBacktickSeq(value) + 1
+	if ticksCount < 3 {
+		ticksCount = 3
+	}
+
+	_, _ = buf.WriteString("\n\n")
+	_, _ = buf.Write(bytes.Repeat([]byte{'`'}, ticksCount))
+	_, _ = buf.WriteString(document.OutputLanguage)
+	serializeFencedCodeAttributes(buf, &Cell{Metadata: attributes})
+	_ = buf.WriteByte('\n')
+	if value != "" {
+		_, _ = buf.WriteString(value)
+		_ = buf.WriteByte('\n')
+	}
+	_, _ = buf.Write(bytes.Repeat([]byte{'`'}, ticksCount))
+}
+
+// appendOutput adds the output block to the outputs of the cell.
+// A block with an exit code starts a new output; otherwise, it is
+// a continuation of the previous one.
+func appendOutput(cell *Cell, block *document.CodeBlock) {
+	attributes := block.Attributes()
+	value := string(block.Content())
+	if value != "" {
+		value += "\n"
+	}
+
+	var output *CellOutput
+	if n := len(cell.Outputs); n > 0 {
+		output = cell.Outputs[n-1]
+	}
+	if _, ok := attributes["exitCode"]; ok || output == nil {
+		output = &CellOutput{}
+		cell.Outputs = append(cell.Outputs, output)
+	}
+
+	if v, ok := attributes["exitCode"]; ok {
+		output.ExitCode, _ = strconv.Atoi(v)
+	}
+	if v, ok := attributes["mime"]; ok {
+		output.MIME = v
+	}
+	if v, ok := attributes["start"]; ok {
+		output.StartTime, _ = time.Parse(time.RFC3339Nano, v)
+	}
+	if v, ok := attributes["end"]; ok {
+		output.EndTime, _ = time.Parse(time.RFC3339Nano, v)
+	}
+
+	switch attributes["stream"] {
+	case outputStreamStderr:
+		output.Stderr += value
+	case "", outputStreamStdout:
+		output.Stdout += value
+	}
+}
+
+// StripOutputs removes outputs from cells, so that they are not serialized.
+func (n *Notebook) StripOutputs() {
+	for _, cell := range n.Cells {
+		cell.Outputs = nil
+	}
+}
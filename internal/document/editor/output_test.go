@@ -0,0 +1,74 @@
+package editor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditor_Outputs(t *testing.T) {
+	data := []byte("```sh { name=hello }\necho hello\necho world >&2\n```\n\n```output { end=2023-01-02T15:04:06Z exitCode=1 start=2023-01-02T15:04:05Z }\nhello\n```\n\n```output { stream=stderr }\nworld\n```\n\nDone.\n")
+
+	notebook, err := DeserializeWithOutputs(data)
+	require.NoError(t, err)
+	require.Len(t, notebook.Cells, 2)
+	assert.Equal(t, "echo hello\necho world >&2", notebook.Cells[0].Value)
+	assert.Equal(
+		t,
+		[]*CellOutput{
+			{
+				Stdout:    "hello\n",
+				Stderr:    "world\n",
+				ExitCode:  1,
+				StartTime: time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC),
+				EndTime:   time.Date(2023, 1, 2, 15, 4, 6, 0, time.UTC),
+			},
+		},
+		notebook.Cells[0].Outputs,
+	)
+	assert.Equal(t, "Done.", notebook.Cells[1].Value)
+
+	notebook.StripSources()
+	result, err := Serialize(notebook)
+	require.NoError(t, err)
+	assert.Equal(t, string(data), string(result))
+
+	notebook.StripOutputs()
+	result, err = Serialize(notebook)
+	require.NoError(t, err)
+	assert.Equal(t, "```sh { name=hello }\necho hello\necho world >&2\n```\n\nDone.\n", string(result))
+}
+
+func TestEditor_OutputsNotRequested(t *testing.T) {
+	data := []byte("```sh\necho hello\n```\n\n```output { exitCode=0 }\nhello\n```\n")
+
+	notebook, err := Deserialize(data)
+	require.NoError(t, err)
+	require.Len(t, notebook.Cells, 2)
+	assert.Empty(t, notebook.Cells[0].Outputs)
+	assert.Equal(t, MarkupKind, notebook.Cells[1].Kind)
+
+	// Output blocks are kept even if outputs are stripped.
+	notebook.StripOutputs()
+	result, err := Serialize(notebook)
+	require.NoError(t, err)
+	assert.Equal(t, string(data), string(result))
+}
+
+func TestEditor_OutputsNew(t *testing.T) {
+	notebook, err := Deserialize([]byte("```sh\necho ```\n```\n"))
+	require.NoError(t, err)
+
+	// Fences are longer than backticks in outputs.
+	notebook.Cells[0].Outputs = []*CellOutput{{Stdout: "```\n", MIME: "text/markdown"}, {ExitCode: 2}}
+	result, err := Serialize(notebook)
+	require.NoError(t, err)
+	assert.Equal(t, "```sh\necho ```\n```\n\n````output { exitCode=0 mime=text/markdown }\n```\n````\n\n```output { exitCode=2 }\n```\n", string(result))
+
+	notebook, err = DeserializeWithOutputs(result)
+	require.NoError(t, err)
+	require.Len(t, notebook.Cells, 1)
+	assert.Equal(t, []*CellOutput{{Stdout: "```\n", MIME: "text/markdown"}, {ExitCode: 2}}, notebook.Cells[0].Outputs)
+}
@@ -0,0 +1,92 @@
+package editor
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/stateful/runme/internal/document"
+)
+
+const (
+	// sourceAttribute stores the original source of a cell, including
+	// any text up to the next cell, like blank lines or link reference
+	// definitions.
+	sourceAttribute = "source"
+	// digestAttribute stores the digest of the serialized cell
+	// at the time of deserialization. As long as it matches,
+	// the cell is considered unchanged.
+	digestAttribute = "digest"
+)
+
+// attachSources stores the original source in cells created from top-level
+// blocks, so that they can be serialized byte-for-byte if unchanged.
+// Cells from nested blocks, for example, code blocks in list items,
+// are always rendered again because they are flattened.
+func attachSources(cells []*Cell, node *document.Node, source []byte) {
+	topLevel := make(map[document.Range]bool)
+	for _, child := range node.Children() {
+		topLevel[child.Item().Range()] = true
+	}
+
+	for idx, cell := range cells {
+		if cell.TextRange == nil || !topLevel[*cell.TextRange] {
+			continue
+		}
+
+		end := len(source)
+		if idx+1 < len(cells) && cells[idx+1].TextRange != nil {
+			end = cells[idx+1].TextRange.Start.Offset
+		}
+
+		if cell.Metadata == nil {
+			cell.Metadata = make(map[string]string)
+		}
+		cell.Metadata[prefixAttributeName(internalAttributePrefix, sourceAttribute)] = string(source[cell.TextRange.Start.Offset:end])
+		cell.Metadata[prefixAttributeName(internalAttributePrefix, digestAttribute)] = cellDigest(cell)
+	}
+}
+
+// unchangedSource returns the original source of the cell
+// if the cell has not been changed since deserialization.
+func unchangedSource(cell *Cell) (string, bool) {
+	source, ok := cell.Metadata[prefixAttributeName(internalAttributePrefix, sourceAttribute)]
+	if !ok {
+		return "", false
+	}
+	digest := cell.Metadata[prefixAttributeName(internalAttributePrefix, digestAttribute)]
+	if digest == "" || digest != cellDigest(cell) {
+		return "", false
+	}
+	return source, true
+}
+
+func cellDigest(cell *Cell) string {
+	var buf bytes.Buffer
+	serializeCell(&buf, cell)
+	sum := sha256.Sum256(buf.Bytes())
+	return hex.EncodeToString(sum[:])
+}
+
+// trimTrailingBlankLines removes blank lines at the end of the source,
+// keeping the line break of the last line, if any.
+func trimTrailingBlankLines(source string) string {
+	trimmed := strings.TrimRight(source, "\r\n")
+	switch lb := source[len(trimmed):]; {
+	case strings.HasPrefix(lb, "\r\n"):
+		return trimmed + "\r\n"
+	case lb != "":
+		return trimmed + "\n"
+	}
+	return trimmed
+}
+
+// StripSources removes the original sources from cells. Afterwards,
+// all cells are serialized in the canonical format.
+func (n *Notebook) StripSources() {
+	for _, cell := range n.Cells {
+		delete(cell.Metadata, prefixAttributeName(internalAttributePrefix, sourceAttribute))
+		delete(cell.Metadata, prefixAttributeName(internalAttributePrefix, digestAttribute))
+	}
+}
@@ -0,0 +1,152 @@
+package document
+
+import (
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Format extracts code blocks from documents in a markup language
+// other than Markdown, which is parsed by Document. Code blocks
+// of all formats are named, sectioned, and run the same way.
+type Format interface {
+	CodeBlocks(source []byte) (CodeBlocks, error)
+}
+
+var (
+	formatsMu sync.RWMutex
+	formats   = map[string]Format{
+		".adoc":     AsciiDoc,
+		".asciidoc": AsciiDoc,
+		".asc":      AsciiDoc,
+		".org":      Org,
+	}
+)
+
+// RegisterFormat registers the format of files
+// with the extension, for example, ".rst".
+func RegisterFormat(ext string, format Format) {
+	formatsMu.Lock()
+	defer formatsMu.Unlock()
+	formats[strings.ToLower(ext)] = format
+}
+
+// LookupFormat returns the format of the file based on its extension.
+// It returns false for Markdown and files with unknown extensions.
+func LookupFormat(filename string) (Format, bool) {
+	formatsMu.RLock()
+	defer formatsMu.RUnlock()
+	format, ok := formats[strings.ToLower(filepath.Ext(filename))]
+	return format, ok
+}
+
+// lineBlocks collects code blocks of line-based formats. It keeps
+// track of headings and paragraphs, which provide sections and intros.
+type lineBlocks struct {
+	source       []byte
+	lines        lineIndex
+	nameResolver *nameResolver
+	headings     headings
+	// paragraph is the last paragraph or heading. Like in Markdown,
+	// it is the intro of a code block following it.
+	paragraph []string
+	// closed reports whether the paragraph is followed by
+	// a blank line or anything else than prose.
+	closed bool
+	result CodeBlocks
+}
+
+func newLineBlocks(source []byte) *lineBlocks {
+	return &lineBlocks{
+		source: source,
+		lines:  newLineIndex(source),
+		nameResolver: &nameResolver{
+			namesCounter: map[string]int{},
+			cache:        map[interface{}]string{},
+		},
+	}
+}
+
+// text adds a line of prose. Blank lines close paragraphs.
+func (b *lineBlocks) text(line string) {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		b.closed = true
+		return
+	}
+	if b.closed {
+		b.paragraph, b.closed = b.paragraph[:0], false
+	}
+	b.paragraph = append(b.paragraph, line)
+}
+
+func (b *lineBlocks) heading(level int, title string) {
+	b.headings = b.headings.push(level, title)
+	b.paragraph, b.closed = append(b.paragraph[:0], title), true
+}
+
+// title replaces the paragraph with the title of a code block.
+func (b *lineBlocks) title(title string) {
+	b.paragraph, b.closed = append(b.paragraph[:0], title), true
+}
+
+// add adds a code block occupying the source between
+// the offsets start and stop, including its delimiters.
+func (b *lineBlocks) add(start, stop int, language string, attributes map[string]string, rawLines []string) {
+	lines := make([]string, 0, len(rawLines))
+	for _, line := range rawLines {
+		lines = append(lines, normalizeLine(line))
+	}
+
+	baseName := getBaseName(attributes, lines)
+	name := b.nameResolver.Get(start, baseName)
+	intro := normalizeIntro(strings.Join(b.paragraph, " "))
+
+	b.result = append(b.result, &CodeBlock{
+		attributes: attributes,
+		intro:      &intro,
+		language:   language,
+		lines:      lines,
+		name:       name,
+		rawLines:   rawLines,
+		rng:        b.lines.newRange(start, stop, Position{}),
+		sections:   b.headings.titles(),
+		source:     b.source,
+		suffixed:   name != baseName,
+		value:      &renderedValue{value: b.source[start:stop], done: true},
+	})
+
+	b.paragraph, b.closed = b.paragraph[:0], true
+}
+
+// offset returns the offset of the line
+// with the index i, counting from 0.
+func (b *lineBlocks) offset(i int) int {
+	if i >= len(b.lines) {
+		return len(b.source)
+	}
+	return b.lines[i]
+}
+
+// lineEnd returns the offset of the end of the line with
+// the index i, excluding the line break.
+func (b *lineBlocks) lineEnd(i int) int {
+	return lineEnd(b.source, b.offset(i))
+}
+
+// splitLines splits the source into lines without line breaks.
+func splitLines(source []byte) []string {
+	lines := strings.Split(string(source), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSuffix(line, "\r")
+	}
+	return lines
+}
+
+// unquote removes single or double quotes around the value.
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
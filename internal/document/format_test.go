@@ -0,0 +1,30 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupFormat(t *testing.T) {
+	format, ok := LookupFormat("docs/Handbook.ADOC")
+	assert.True(t, ok)
+	assert.Equal(t, AsciiDoc, format)
+
+	format, ok = LookupFormat("notes.org")
+	assert.True(t, ok)
+	assert.Equal(t, Org, format)
+
+	_, ok = LookupFormat("README.md")
+	assert.False(t, ok)
+
+	RegisterFormat(".txt", Org)
+	defer func() {
+		formatsMu.Lock()
+		delete(formats, ".txt")
+		formatsMu.Unlock()
+	}()
+	format, ok = LookupFormat("notes.txt")
+	assert.True(t, ok)
+	assert.Equal(t, Org, format)
+}
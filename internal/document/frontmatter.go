@@ -0,0 +1,97 @@
+package document
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"sort"
+
+	"github.com/BurntSushi/toml"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// Frontmatter is a typed configuration of a document
+// declared in its front matter. It describes how code blocks
+// from the document should be executed.
+type Frontmatter struct {
+	// Shell is a program used to execute shell code blocks,
+	// for example "bash" or "/bin/zsh". If empty, $SHELL is used.
+	Shell string `json:"shell,omitempty" yaml:"shell,omitempty" toml:"shell,omitempty"`
+	// Cwd is a working directory. If relative, it is resolved
+	// against the directory containing the document.
+	Cwd string `json:"cwd,omitempty" yaml:"cwd,omitempty" toml:"cwd,omitempty"`
+	// Env is a set of initial environment variables.
+	Env map[string]string `json:"env,omitempty" yaml:"env,omitempty" toml:"env,omitempty"`
+	// SkipPrompts disables interactive prompts.
+	SkipPrompts bool `json:"skipPrompts,omitempty" yaml:"skipPrompts,omitempty" toml:"skipPrompts,omitempty"`
+	// Tools is a list of programs that must be available
+	// in $PATH in order to execute the document.
+	Tools []string `json:"tools,omitempty" yaml:"tools,omitempty" toml:"tools,omitempty"`
+}
+
+// ParseFrontmatter decodes raw front matter, as returned by ParseSections,
+// into Frontmatter. The format is detected based on the delimiter:
+// "---" for YAML, "+++" for TOML, and "{" for JSON.
+// Empty input results in an empty Frontmatter.
+func ParseFrontmatter(raw []byte) (*Frontmatter, error) {
+	var fm Frontmatter
+
+	raw = bytes.TrimSpace(raw)
+
+	switch {
+	case len(raw) == 0:
+		return &fm, nil
+	case bytes.HasPrefix(raw, []byte("---")):
+		data := trimFrontmatterDelimiters(raw, []byte("---"))
+		if err := yaml.Unmarshal(data, &fm); err != nil {
+			return nil, errors.Wrap(err, "failed to parse YAML front matter")
+		}
+	case bytes.HasPrefix(raw, []byte("+++")):
+		data := trimFrontmatterDelimiters(raw, []byte("+++"))
+		if err := toml.Unmarshal(data, &fm); err != nil {
+			return nil, errors.Wrap(err, "failed to parse TOML front matter")
+		}
+	case raw[0] == '{':
+		if err := json.Unmarshal(raw, &fm); err != nil {
+			return nil, errors.Wrap(err, "failed to parse JSON front matter")
+		}
+	default:
+		return nil, errors.New("unknown front matter format")
+	}
+
+	return &fm, nil
+}
+
+func trimFrontmatterDelimiters(raw, delimiter []byte) []byte {
+	raw = bytes.TrimPrefix(raw, delimiter)
+	raw = bytes.TrimSuffix(raw, delimiter)
+	return raw
+}
+
+// Envs returns Env as a list of "KEY=VALUE" pairs sorted by key.
+func (f *Frontmatter) Envs() []string {
+	keys := make([]string, 0, len(f.Env))
+	for k := range f.Env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	envs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		envs = append(envs, k+"="+f.Env[k])
+	}
+	return envs
+}
+
+// Dir returns Cwd resolved against base. It returns base
+// if Cwd is empty.
+func (f *Frontmatter) Dir(base string) string {
+	if f.Cwd == "" {
+		return base
+	}
+	if filepath.IsAbs(f.Cwd) {
+		return f.Cwd
+	}
+	return filepath.Join(base, f.Cwd)
+}
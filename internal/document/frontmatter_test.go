@@ -0,0 +1,87 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFrontmatter(t *testing.T) {
+	expected := &Frontmatter{
+		Shell:       "bash",
+		Cwd:         "scripts",
+		Env:         map[string]string{"FOO": "bar", "BAZ": "qux"},
+		SkipPrompts: true,
+		Tools:       []string{"docker", "kubectl"},
+	}
+
+	t.Run("YAML", func(t *testing.T) {
+		fm, err := ParseFrontmatter([]byte(`---
+shell: bash
+cwd: scripts
+env:
+  FOO: bar
+  BAZ: qux
+skipPrompts: true
+tools: [docker, kubectl]
+---`))
+		require.NoError(t, err)
+		assert.Equal(t, expected, fm)
+	})
+
+	t.Run("TOML", func(t *testing.T) {
+		fm, err := ParseFrontmatter([]byte(`+++
+shell = "bash"
+cwd = "scripts"
+skipPrompts = true
+tools = ["docker", "kubectl"]
+
+[env]
+FOO = "bar"
+BAZ = "qux"
++++`))
+		require.NoError(t, err)
+		assert.Equal(t, expected, fm)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		fm, err := ParseFrontmatter([]byte(`{
+  "shell": "bash",
+  "cwd": "scripts",
+  "env": {"FOO": "bar", "BAZ": "qux"},
+  "skipPrompts": true,
+  "tools": ["docker", "kubectl"]
+}`))
+		require.NoError(t, err)
+		assert.Equal(t, expected, fm)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		fm, err := ParseFrontmatter(nil)
+		require.NoError(t, err)
+		assert.Equal(t, &Frontmatter{}, fm)
+	})
+
+	t.Run("UnknownKeys", func(t *testing.T) {
+		fm, err := ParseFrontmatter([]byte("---\ntitle: Example\nshell: zsh\n---"))
+		require.NoError(t, err)
+		assert.Equal(t, &Frontmatter{Shell: "zsh"}, fm)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := ParseFrontmatter([]byte("---\nshell: [bash\n---"))
+		assert.Error(t, err)
+	})
+}
+
+func TestFrontmatter_Envs(t *testing.T) {
+	fm := &Frontmatter{Env: map[string]string{"B": "2", "A": "1"}}
+	assert.Equal(t, []string{"A=1", "B=2"}, fm.Envs())
+}
+
+func TestFrontmatter_Dir(t *testing.T) {
+	assert.Equal(t, "/base", (&Frontmatter{}).Dir("/base"))
+	assert.Equal(t, "/base/sub", (&Frontmatter{Cwd: "sub"}).Dir("/base"))
+	assert.Equal(t, "/abs", (&Frontmatter{Cwd: "/abs"}).Dir("/base"))
+}
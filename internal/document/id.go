@@ -0,0 +1,102 @@
+package document
+
+import (
+	"bytes"
+
+	"github.com/rs/xid"
+	"github.com/stateful/runme/internal/langs"
+	"github.com/yuin/goldmark/ast"
+)
+
+// IDAttribute is the name of the attribute holding a stable
+// identifier of a code block. Unlike the name, which may be
+// generated from the content, it does not change when
+// the document is edited.
+const IDAttribute = "id"
+
+// NewID returns a new unique code block identifier.
+func NewID() string {
+	return xid.New().String()
+}
+
+// ID returns the value of the "id" attribute, if set.
+func (b *CodeBlock) ID() string {
+	return b.attributes[IDAttribute]
+}
+
+// InsertIDs returns source in which every fenced code block that
+// can be run and has no "id" attribute gets one. Code blocks without
+// a language cannot hold attributes, hence, they are skipped even if
+// their language can be detected. The rest of the source is left intact.
+func InsertIDs(source []byte) ([]byte, error) {
+	sections, err := ParseSections(source)
+	if err != nil {
+		return nil, err
+	}
+
+	// Content always extends to the end of the source.
+	offset := len(source) - len(sections.Content)
+
+	doc := New(sections.Content, nil)
+	astNode := doc.parse()
+
+	var (
+		result []byte
+		last   int
+	)
+
+	err = ast.Walk(astNode, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering || node.Kind() != ast.KindFencedCodeBlock {
+			return ast.WalkContinue, nil
+		}
+
+		codeBlock := node.(*ast.FencedCodeBlock)
+		if codeBlock.Info == nil || !langs.IsSupported(string(codeBlock.Language(sections.Content))) {
+			return ast.WalkContinue, nil
+		}
+		attributes, _ := getAttributes(codeBlock, sections.Content)
+		if _, ok := attributes[IDAttribute]; ok {
+			return ast.WalkContinue, nil
+		}
+
+		segment := codeBlock.Info.Segment
+		info, ok := infoWithID(segment.Value(sections.Content), NewID())
+		if !ok {
+			return ast.WalkContinue, nil
+		}
+
+		result = append(result, source[last:offset+segment.Start]...)
+		result = append(result, info...)
+		last = offset + segment.Stop
+
+		return ast.WalkContinue, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result = append(result, source[last:]...)
+	return result, nil
+}
+
+func infoWithID(info []byte, id string) ([]byte, bool) {
+	attr := IDAttribute + "=" + id
+
+	start, stop := rawAttributesBounds(info)
+	if start == -1 {
+		if bytes.IndexByte(info, '{') >= 0 {
+			// Unterminated attributes; leave them as they are.
+			return info, false
+		}
+		return []byte(string(bytes.TrimRight(info, " ")) + " { " + attr + " }"), true
+	}
+
+	raw := bytes.TrimSpace(info[start+1 : stop])
+	if len(raw) == 0 {
+		return []byte(string(bytes.TrimRight(info[:start], " ")) + " { " + attr + " }" + string(info[stop+1:])), true
+	}
+
+	// Insert the new attribute right after the last existing one.
+	end := bytes.LastIndexFunc(info[:stop], func(r rune) bool { return r != ' ' && r != '\t' }) + 1
+	return []byte(string(info[:end]) + " " + attr + string(info[end:])), true
+}
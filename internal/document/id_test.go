@@ -0,0 +1,83 @@
+package document
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInsertIDs(t *testing.T) {
+	data := []byte(`---
+title: Example
+---
+
+# Example
+
+` + "```sh\necho 1\n```" + `
+
+` + "```sh { name=hello }\necho hello\n```" + `
+
+` + "```sh { id=existing }\necho 2\n```" + `
+
+` + "```\nno language\n```" + `
+
+` + "```json\n{}\n```" + `
+`)
+
+	result, err := InsertIDs(data)
+	require.NoError(t, err)
+
+	blocks := testCodeBlocks(t, string(result[len("---\ntitle: Example\n---\n"):]))
+	require.Len(t, blocks, 5)
+	assert.NotEmpty(t, blocks[0].ID())
+	assert.NotEmpty(t, blocks[1].ID())
+	assert.NotEqual(t, blocks[0].ID(), blocks[1].ID())
+	assert.Equal(t, "hello", blocks[1].Name())
+	assert.Equal(t, "existing", blocks[2].ID())
+	assert.Empty(t, blocks[3].ID())
+	assert.Empty(t, blocks[4].ID())
+
+	expected := string(data)
+	expected = strings.Replace(expected, "```sh\n", "```sh { id="+blocks[0].ID()+" }\n", 1)
+	expected = strings.Replace(expected, "```sh { name=hello }", "```sh { name=hello id="+blocks[1].ID()+" }", 1)
+	assert.Equal(t, expected, string(result))
+
+	// Running it again does not change anything.
+	again, err := InsertIDs(result)
+	require.NoError(t, err)
+	assert.Equal(t, string(result), string(again))
+}
+
+func Test_infoWithID(t *testing.T) {
+	testCases := []struct {
+		info     string
+		expected string
+		ok       bool
+	}{
+		{"sh", "sh { id=ID }", true},
+		{"sh {}", "sh { id=ID }", true},
+		{"sh { name=sh }", "sh { name=sh id=ID }", true},
+		{`sh { description="a } b" }`, `sh { description="a } b" id=ID }`, true},
+		{"sh { name=echo", "sh { name=echo", false},
+	}
+
+	for _, tc := range testCases {
+		result, ok := infoWithID([]byte(tc.info), "ID")
+		assert.Equal(t, tc.ok, ok, tc.info)
+		assert.Equal(t, tc.expected, string(result), tc.info)
+	}
+}
+
+func TestCodeBlocks_Lookup(t *testing.T) {
+	blocks := testCodeBlocks(t, "```sh { id=first }\necho 1\n```\n\n"+
+		"```sh { name=first }\necho 2\n```\n\n"+
+		"```sh\necho 3\n```\n")
+
+	assert.Equal(t, []string{"echo 1"}, blocks.Lookup("first").Lines())
+	assert.Equal(t, []string{"echo 3"}, blocks.Lookup("echo-3").Lines())
+	assert.Nil(t, blocks.Lookup("unknown"))
+	assert.Nil(t, blocks.Lookup(""))
+	assert.Equal(t, []string{"first"}, blocks.IDs())
+}
@@ -0,0 +1,142 @@
+package document
+
+import (
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// IncludeLanguage is the language of a fenced code block which
+// includes code blocks of other markdown files, for example:
+//
+//	```runme-include { namespace=setup }
+//	shared/setup.md
+//	```
+//
+// Each line is a path relative to the including file. Blocks of
+// the included file are namespaced with the "namespace" attribute
+// or, by default, with the file name without extension, hence
+// "db-migrate" from "shared/setup.md" becomes "setup/db-migrate".
+// An empty namespace disables it. Paths may not leave the root of
+// the file system given to Document.WithFS, which the CLI sets to
+// the root of the repository. A file included again in the same
+// namespace is skipped.
+const IncludeLanguage = "runme-include"
+
+// Filename returns the name of the file the code block comes from.
+// It is set only when includes are enabled with Document.WithFS.
+func (b *CodeBlock) Filename() string {
+	return b.filename
+}
+
+// Namespace returns the namespace of an included code block.
+// It is empty for code blocks of the top-level document.
+func (b *CodeBlock) Namespace() string {
+	return b.namespace
+}
+
+func (b *CodeBlock) setNamespace(namespace string) {
+	if namespace == "" {
+		return
+	}
+	b.namespace = namespace
+	b.name = namespace + "/" + b.name
+}
+
+func (b *CodeBlock) includePaths() (result []string) {
+	for _, line := range b.Lines() {
+		if line != "" && !strings.HasPrefix(line, "#") {
+			result = append(result, line)
+		}
+	}
+	return result
+}
+
+func (d *Document) include(block *CodeBlock, node *Node) error {
+	for _, name := range block.includePaths() {
+		filename := path.Join(path.Dir(d.filename), name)
+		if !fs.ValidPath(filename) {
+			return errors.Errorf("%s: cannot include %q: path outside of the root directory", d.filename, name)
+		}
+
+		stack := append(d.includes[:len(d.includes):len(d.includes)], d.filename)
+		for idx, item := range stack {
+			if item == filename {
+				cycle := append(stack[idx:len(stack):len(stack)], filename)
+				return errors.Errorf("include cycle: %s", strings.Join(cycle, " -> "))
+			}
+		}
+
+		data, err := fs.ReadFile(d.fsys, filename)
+		if err != nil {
+			return errors.Wrapf(err, "%s: failed to include %q", d.filename, name)
+		}
+
+		sections, err := ParseSections(data)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse %s", filename)
+		}
+
+		namespace, ok := block.Attributes()["namespace"]
+		if !ok {
+			namespace = strings.TrimSuffix(path.Base(filename), path.Ext(filename))
+		}
+		if d.namespace != "" && namespace != "" {
+			namespace = d.namespace + "/" + namespace
+		} else if namespace == "" {
+			namespace = d.namespace
+		}
+
+		// A file included more than once in the same namespace,
+		// for example, by two included files, would only repeat
+		// its code blocks.
+		if d.included == nil {
+			d.included = make(map[string]bool)
+		}
+		key := filename + ":" + namespace
+		if d.included[key] {
+			continue
+		}
+		d.included[key] = true
+
+		// Errors of rendering included blocks are recorded by d.
+		included := New(sections.Content, d.render).
+			WithBase(sections.ContentStart).
+			WithFS(d.fsys, filename)
+		included.includes = stack
+		included.included = d.included
+		included.headings = d.headings.enclose()
+		included.namespace = namespace
+
+		root, _, err := included.Parse()
+		if err != nil {
+			return err
+		}
+
+		for _, child := range root.children {
+			child.parent = node
+			node.children = append(node.children, child)
+		}
+	}
+	return nil
+}
+
+// checkIncludedNames returns an error if code blocks from different
+// files have the same name. Names are unique within a file.
+func checkIncludedNames(blocks CodeBlocks) error {
+	seen := make(map[string]*CodeBlock, len(blocks))
+	for _, block := range blocks {
+		if other, ok := seen[block.Name()]; ok {
+			return errors.Errorf(
+				"code blocks %s:%d and %s:%d have the same name %q; set the namespace of includes to tell them apart",
+				other.Filename(), other.Range().Start.Line,
+				block.Filename(), block.Range().Start.Line,
+				block.Name(),
+			)
+		}
+		seen[block.Name()] = block
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package document
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stateful/runme/internal/renderer/cmark"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testIncludeBlocks(fsys fstest.MapFS, filename string) (CodeBlocks, error) {
+	doc := New(fsys[filename].Data, cmark.Render).WithFS(fsys, filename)
+	node, _, err := doc.Parse()
+	if err != nil {
+		return nil, err
+	}
+	return CollectCodeBlocks(node), nil
+}
+
+func TestDocument_Include(t *testing.T) {
+	fsys := fstest.MapFS{
+		"README.md":       {Data: []byte("# Service\n\n```runme-include\nshared/setup.md\n```\n\n```sh { name=start requires=setup/db-migrate }\necho start\n```\n")},
+		"shared/setup.md": {Data: []byte("---\nshell: bash\n---\n\n# Setup\n\n```sh { name=db-migrate requires=deps }\necho migrate\n```\n\n```sh { name=deps }\necho deps\n```\n")},
+	}
+
+	blocks, err := testIncludeBlocks(fsys, "README.md")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"setup/db-migrate", "setup/deps", "start"}, blocks.Names())
+
+	migrate := blocks.Lookup("setup/db-migrate")
+	require.NotNil(t, migrate)
+	assert.Equal(t, "setup", migrate.Namespace())
+	assert.Equal(t, "shared/setup.md", migrate.Filename())
+	assert.Equal(t, 7, migrate.Range().Start.Line)
+	assert.Equal(t, []string{"setup/deps"}, migrate.Requires())
+	assert.Equal(t, "README.md", blocks.Lookup("start").Filename())
+
+	result, err := blocks.ResolveRequires("start")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"setup/deps", "setup/db-migrate", "start"}, result.Names())
+}
+
+func TestDocument_IncludeNamespace(t *testing.T) {
+	fsys := fstest.MapFS{
+		"README.md":   {Data: []byte("```runme-include { namespace=common }\na.md\n```\n\n```runme-include { namespace= }\nb.md\n```\n")},
+		"a.md":        {Data: []byte("```runme-include\nnested/c.md\n```\n")},
+		"b.md":        {Data: []byte("```sh { name=b }\necho b\n```\n")},
+		"nested/c.md": {Data: []byte("```sh { name=c }\necho c\n```\n")},
+	}
+
+	blocks, err := testIncludeBlocks(fsys, "README.md")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"common/c/c", "b"}, blocks.Names())
+	assert.Equal(t, "nested/c.md", blocks[0].Filename())
+}
+
+func TestDocument_IncludeDiamond(t *testing.T) {
+	fsys := fstest.MapFS{
+		"README.md":    {Data: []byte("```runme-include { namespace= }\nb.md\nc.md\n```\n")},
+		"b.md":         {Data: []byte("```runme-include { namespace=common }\nshared/d.md\n```\n\n```sh { name=b requires=common/d }\necho b\n```\n")},
+		"c.md":         {Data: []byte("```runme-include { namespace=common }\nshared/d.md\n```\n\n```sh { name=c requires=common/d }\necho c\n```\n")},
+		"shared/d.md":  {Data: []byte("```sh { name=d }\necho d\n```\n")},
+		"docs/SUB.md":  {Data: []byte("```runme-include\n../shared/d.md\n```\n")},
+		"docs/BOTH.md": {Data: []byte("```runme-include { namespace= }\n../b.md\n../c.md\n```\n\n```sh { name=b }\necho other b\n```\n")},
+	}
+
+	blocks, err := testIncludeBlocks(fsys, "README.md")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"common/d", "b", "c"}, blocks.Names())
+
+	// Paths are relative to the including file.
+	blocks, err = testIncludeBlocks(fsys, "docs/SUB.md")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"d/d"}, blocks.Names())
+	assert.Equal(t, "shared/d.md", blocks[0].Filename())
+
+	// Blocks with the same name in different files are reported.
+	_, err = testIncludeBlocks(fsys, "docs/BOTH.md")
+	assert.EqualError(t, err, `code blocks b.md:5 and docs/BOTH.md:6 have the same name "b"; set the namespace of includes to tell them apart`)
+}
+
+func TestDocument_IncludeErrors(t *testing.T) {
+	t.Run("Cycle", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"README.md": {Data: []byte("```runme-include\na.md\n```\n")},
+			"a.md":      {Data: []byte("```runme-include\nb.md\n```\n")},
+			"b.md":      {Data: []byte("```runme-include\na.md\n```\n")},
+		}
+		_, err := testIncludeBlocks(fsys, "README.md")
+		assert.ErrorContains(t, err, "include cycle: a.md -> b.md -> a.md")
+	})
+
+	t.Run("Self", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"README.md": {Data: []byte("```runme-include\nREADME.md\n```\n")},
+		}
+		_, err := testIncludeBlocks(fsys, "README.md")
+		assert.ErrorContains(t, err, "include cycle: README.md -> README.md")
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"README.md": {Data: []byte("```runme-include\nmissing.md\n```\n")},
+		}
+		_, err := testIncludeBlocks(fsys, "README.md")
+		assert.ErrorContains(t, err, `README.md: failed to include "missing.md"`)
+	})
+
+	t.Run("OutsideRoot", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"README.md": {Data: []byte("```runme-include\n../setup.md\n```\n")},
+		}
+		_, err := testIncludeBlocks(fsys, "README.md")
+		assert.ErrorContains(t, err, `cannot include "../setup.md"`)
+	})
+}
+
+func TestDocument_IncludeDisabled(t *testing.T) {
+	blocks := testCodeBlocks(t, "```runme-include\nsetup.md\n```\n")
+	require.Len(t, blocks, 1)
+	assert.Equal(t, IncludeLanguage, blocks[0].Language())
+}
@@ -0,0 +1,127 @@
+package document
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Org is the Format of Org-mode documents. Code blocks are source
+// blocks whose header arguments become attributes, for example:
+//
+//	#+name: hello
+//	#+begin_src sh :timeout 5s
+//	echo hello
+//	#+end_src
+//
+// The name keyword names the block unless the name header argument is set.
+var Org Format = org{}
+
+type org struct{}
+
+var (
+	orgHeadingRe    = regexp.MustCompile(`^(\*+)\s+(.*?)(?:\s+:[\w@#%:]+:)?\s*$`)
+	orgNameRe       = regexp.MustCompile(`(?i)^\s*#\+name:\s*(.*\S)`)
+	orgBeginSrcRe   = regexp.MustCompile(`(?i)^(\s*)#\+begin_src(?:\s+([^\s:]\S*))?(.*)$`)
+	orgEndSrcRe     = regexp.MustCompile(`(?i)^\s*#\+end_src\s*$`)
+	orgBeginBlockRe = regexp.MustCompile(`(?i)^\s*#\+begin_(example|comment|export)\b`)
+	orgKeywordRe    = regexp.MustCompile(`^\s*#\+\w+:`)
+	orgHeaderArgRe  = regexp.MustCompile(`(?:^|\s):([\w-]+)`)
+)
+
+func (org) CodeBlocks(source []byte) (CodeBlocks, error) {
+	var (
+		b     = newLineBlocks(source)
+		lines = splitLines(source)
+		// name and nameLine come from the name keyword
+		// preceding the block.
+		name     string
+		nameLine = -1
+	)
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		if m := orgBeginSrcRe.FindStringSubmatch(line); m != nil {
+			end := findLine(lines, i+1, orgEndSrcRe.MatchString)
+
+			attributes := parseOrgHeaderArgs(m[3])
+			if name != "" && attributes["name"] == "" {
+				attributes["name"] = name
+			}
+
+			start := i
+			if nameLine != -1 {
+				start = nameLine
+			}
+
+			content := make([]string, 0, end-i-1)
+			for _, l := range lines[i+1 : end] {
+				content = append(content, unescapeOrgLine(strings.TrimPrefix(l, m[1])))
+			}
+
+			b.add(b.offset(start), b.lineEnd(end), m[2], attributes, content)
+
+			name, nameLine = "", -1
+			i = end
+			continue
+		}
+
+		if m := orgNameRe.FindStringSubmatch(line); m != nil {
+			name, nameLine = m[1], i
+			continue
+		}
+
+		name, nameLine = "", -1
+
+		switch {
+		case orgBeginBlockRe.MatchString(line):
+			end := "#+end_" + strings.ToLower(orgBeginBlockRe.FindStringSubmatch(line)[1])
+			i = findLine(lines, i+1, func(l string) bool {
+				return strings.HasPrefix(strings.ToLower(strings.TrimSpace(l)), end)
+			})
+			b.text("")
+		case orgHeadingRe.MatchString(line):
+			m := orgHeadingRe.FindStringSubmatch(line)
+			b.heading(len(m[1]), m[2])
+		case orgKeywordRe.MatchString(line), strings.HasPrefix(strings.TrimSpace(line), "# "):
+			// Keywords and comments are not prose.
+			b.text("")
+		default:
+			b.text(line)
+		}
+	}
+
+	return b.result, nil
+}
+
+// parseOrgHeaderArgs parses header arguments like ":timeout 5s :results output".
+// Arguments without a value are set to "true".
+func parseOrgHeaderArgs(s string) map[string]string {
+	attributes := make(map[string]string)
+
+	matches := orgHeaderArgRe.FindAllStringSubmatchIndex(s, -1)
+	for i, m := range matches {
+		stop := len(s)
+		if i+1 < len(matches) {
+			stop = matches[i+1][0]
+		}
+		value := strings.TrimSpace(s[m[1]:stop])
+		if value == "" {
+			value = "true"
+		}
+		attributes[s[m[2]:m[3]]] = unquote(value)
+	}
+
+	return attributes
+}
+
+// unescapeOrgLine removes the comma Org-mode puts before
+// lines in source blocks which would be taken for headings
+// or keywords, like ",* item" or ",#+end_src".
+func unescapeOrgLine(line string) string {
+	trimmed := strings.TrimLeft(line, " \t")
+	if strings.HasPrefix(trimmed, ",*") || strings.HasPrefix(trimmed, ",#+") {
+		return line[:len(line)-len(trimmed)] + trimmed[1:]
+	}
+	return line
+}
@@ -0,0 +1,66 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrg_CodeBlocks(t *testing.T) {
+	source := []byte(`#+title: Handbook
+
+* Setup :ops:
+
+Install dependencies.
+
+#+NAME: install
+#+BEGIN_SRC sh :dir /tmp :results output
+npm install
+#+END_SRC
+
+** Checks
+Lint the code:
+  #+begin_src bash :name lint :async
+    npm run lint
+  ,* not a heading
+  ,#+end_src
+  #+end_src
+
+#+begin_example
+#+begin_src sh
+echo example
+#+end_src
+#+end_example
+
+# A comment.
+
+#+begin_src
+echo unknown
+#+end_src
+`)
+
+	blocks, err := Org.CodeBlocks(source)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"install", "lint", "echo-unknown"}, blocks.Names())
+
+	install := blocks[0]
+	assert.Equal(t, "sh", install.Language())
+	assert.Equal(t, map[string]string{"name": "install", "dir": "/tmp", "results": "output"}, install.Attributes())
+	assert.Equal(t, "Install dependencies.", install.Intro())
+	assert.Equal(t, []string{"Setup"}, install.Sections())
+	assert.Equal(t, 7, install.Range().Start.Line)
+	assert.Equal(t, 10, install.Range().End.Line)
+	assert.Equal(t, "npm install", string(install.Content()))
+
+	lint := blocks[1]
+	assert.Equal(t, "bash", lint.Language())
+	assert.Equal(t, map[string]string{"name": "lint", "async": "true"}, lint.Attributes())
+	assert.Equal(t, "Setup/Checks", lint.Section())
+	assert.Equal(t, "Lint the code.", lint.Intro())
+	assert.Equal(t, "  npm run lint\n* not a heading\n#+end_src", string(lint.Content()))
+
+	unknown := blocks[2]
+	assert.Equal(t, "", unknown.Language())
+	assert.Equal(t, "sh", unknown.ProbableLanguage())
+}
@@ -11,6 +11,8 @@ import (
 type ParsedSections struct {
 	FrontMatter []byte
 	Content     []byte
+	// ContentStart is the position of Content in the source.
+	ContentStart Position
 }
 
 func ParseSections(source []byte) (result ParsedSections, _ error) {
@@ -22,6 +24,7 @@ func ParseSections(source []byte) (result ParsedSections, _ error) {
 			result.FrontMatter = item.Value(source)
 		case parsedItemContent:
 			result.Content = item.Value(source)
+			result.ContentStart = positionAt(source, item.start, Position{})
 		case parsedItemError:
 			return result, item.err
 		}
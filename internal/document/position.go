@@ -0,0 +1,221 @@
+package document
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// Position is a location in the source.
+type Position struct {
+	// Line is a line number starting at 1.
+	Line int `json:"line"`
+	// Column is a byte offset in the line starting at 1.
+	Column int `json:"column"`
+	// Offset is a byte offset in the source starting at 0.
+	Offset int `json:"offset"`
+}
+
+// Range is a range of the source occupied by a block.
+// End points right after the last byte of the block.
+type Range struct {
+	Start Position `json:"start"`
+	End   Position `json:"end"`
+}
+
+// PositionAt returns the Position of the offset in the source.
+func PositionAt(source []byte, offset int) Position {
+	return positionAt(source, offset, Position{})
+}
+
+// positionAt returns the Position of the offset in the source.
+// base is a position of the source itself and allows to report
+// positions in a larger document, for example, containing front matter.
+func positionAt(source []byte, offset int, base Position) Position {
+	if base.Line == 0 {
+		base = Position{Line: 1, Column: 1}
+	}
+
+	before := source[:offset]
+	lines := bytes.Count(before, []byte{'\n'})
+
+	column := offset + 1
+	if lines > 0 {
+		column = offset - bytes.LastIndexByte(before, '\n')
+	} else {
+		column += base.Column - 1
+	}
+
+	return Position{
+		Line:   base.Line + lines,
+		Column: column,
+		Offset: base.Offset + offset,
+	}
+}
+
+// lineIndex finds positions of offsets in a source without
+// scanning it each time like positionAt, which makes a difference
+// for large documents with many blocks.
+type lineIndex []int
+
+// newLineIndex returns offsets of the first bytes of lines in the source.
+func newLineIndex(source []byte) lineIndex {
+	index := lineIndex{0}
+	for i := 0; ; {
+		n := bytes.IndexByte(source[i:], '\n')
+		if n == -1 {
+			break
+		}
+		i += n + 1
+		index = append(index, i)
+	}
+	return index
+}
+
+// positionAt is equivalent to the function positionAt.
+func (l lineIndex) positionAt(offset int, base Position) Position {
+	if base.Line == 0 {
+		base = Position{Line: 1, Column: 1}
+	}
+
+	line := sort.SearchInts(l, offset+1) - 1
+
+	column := offset - l[line] + 1
+	if line == 0 {
+		column += base.Column - 1
+	}
+
+	return Position{
+		Line:   base.Line + line,
+		Column: column,
+		Offset: base.Offset + offset,
+	}
+}
+
+func (l lineIndex) newRange(start, stop int, base Position) Range {
+	return Range{
+		Start: l.positionAt(start, base),
+		End:   l.positionAt(stop, base),
+	}
+}
+
+// nodeRange returns offsets of the first and right after the last byte
+// of the block node. Block nodes in goldmark know only about
+// the lines of their content, hence it has to be extended to
+// markers like list bullets, heading markers or code fences.
+func nodeRange(node ast.Node, source []byte) (start, stop int) {
+	start, stop = contentRange(node, source)
+
+	if start == -1 {
+		// There is no content, for example, in a thematic break.
+		// Find the first non-blank line after the closest preceding block.
+		start = 0
+		for n := node; n != nil; n = n.Parent() {
+			if prev := n.PreviousSibling(); prev != nil {
+				_, start = nodeRange(prev, source)
+				break
+			}
+		}
+		for start < len(source) && isBlank(source[start]) {
+			start++
+		}
+		stop = lineEnd(source, start)
+	}
+
+	switch node.Kind() {
+	case ast.KindFencedCodeBlock:
+		start, stop = fencedCodeBlockRange(node.(*ast.FencedCodeBlock), source, start, stop)
+	case ast.KindHeading:
+		// Include the underline of setext headings.
+		isATX := bytes.HasPrefix(bytes.TrimSpace(source[lineStart(source, start):stop]), []byte{'#'})
+		next := lineEnd(source, stop) + 1
+		if !isATX && next < len(source) {
+			line := bytes.TrimSpace(source[next:lineEnd(source, next)])
+			if len(line) > 0 && (len(bytes.Trim(line, "=")) == 0 || len(bytes.Trim(line, "-")) == 0) {
+				stop = lineEnd(source, next)
+			}
+		}
+	}
+
+	return lineStart(source, start), stop
+}
+
+// contentRange returns the range of the segments of the node
+// and its descendant blocks or -1 if there are none.
+func contentRange(node ast.Node, source []byte) (start, stop int) {
+	start, stop = -1, -1
+
+	update := func(s, e int) {
+		if start == -1 || s < start {
+			start = s
+		}
+		if e > stop {
+			stop = e
+		}
+	}
+
+	if lines := node.Lines(); lines != nil && lines.Len() > 0 {
+		first, last := lines.At(0), lines.At(lines.Len()-1)
+		update(first.Start, len(bytes.TrimRight(source[:last.Stop], "\r\n")))
+	}
+
+	if code, ok := node.(*ast.FencedCodeBlock); ok && code.Info != nil {
+		update(code.Info.Segment.Start, code.Info.Segment.Stop)
+	}
+
+	for child := node.FirstChild(); child != nil; child = child.NextSibling() {
+		if child.Type() != ast.TypeBlock {
+			continue
+		}
+		update(nodeRange(child, source))
+	}
+
+	return start, stop
+}
+
+func fencedCodeBlockRange(node *ast.FencedCodeBlock, source []byte, start, stop int) (int, int) {
+	// Without the info string, the opening fence is in the line
+	// preceding the first line of the content.
+	if node.Info == nil && node.Lines().Len() > 0 {
+		start = lineStart(source, start)
+		if start > 0 {
+			start = lineStart(source, start-1)
+		}
+	}
+
+	// The closing fence, if any, is in the line following
+	// the last line of the content.
+	next := lineEnd(source, stop) + 1
+	if next < len(source) {
+		line := bytes.TrimSpace(source[next:lineEnd(source, next)])
+		if bytes.HasPrefix(line, []byte("```")) || bytes.HasPrefix(line, []byte("~~~")) {
+			stop = lineEnd(source, next)
+		}
+	}
+
+	return start, stop
+}
+
+func lineStart(source []byte, offset int) int {
+	return bytes.LastIndexByte(source[:offset], '\n') + 1
+}
+
+func lineEnd(source []byte, offset int) int {
+	if offset >= len(source) {
+		return len(source)
+	}
+	idx := bytes.IndexByte(source[offset:], '\n')
+	if idx == -1 {
+		return len(source)
+	}
+	end := offset + idx
+	if end > offset && source[end-1] == '\r' {
+		end--
+	}
+	return end
+}
+
+func isBlank(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
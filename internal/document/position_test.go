@@ -0,0 +1,90 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/stateful/runme/internal/renderer/cmark"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yuin/goldmark/ast"
+)
+
+func Test_nodeRange(t *testing.T) {
+	source := []byte("# Title\n\nSome *para*\ntext\n\n---\n\nSetext\n======\n\n" +
+		"```sh { name=x }\necho 1\n```\n\n```\nplain\n```\n\n" +
+		"- item 1\n- item 2\n\n  ```sh\n  nested\n  ```\n\n" +
+		"> quote\n> more\n\n    indented\n\n<div>\nhtml\n</div>\n\n```sh\n```\n")
+
+	expected := []struct {
+		kind  ast.NodeKind
+		value string
+	}{
+		{ast.KindHeading, "# Title"},
+		{ast.KindParagraph, "Some *para*\ntext"},
+		{ast.KindThematicBreak, "---"},
+		{ast.KindHeading, "Setext\n======"},
+		{ast.KindFencedCodeBlock, "```sh { name=x }\necho 1\n```"},
+		{ast.KindFencedCodeBlock, "```\nplain\n```"},
+		{ast.KindList, "- item 1\n- item 2\n\n  ```sh\n  nested\n  ```"},
+		{ast.KindBlockquote, "> quote\n> more"},
+		{ast.KindCodeBlock, "    indented"},
+		{ast.KindHTMLBlock, "<div>\nhtml\n</div>"},
+		{ast.KindFencedCodeBlock, "```sh\n```"},
+	}
+
+	doc := New(source, cmark.Render)
+	root := doc.parse()
+
+	idx := 0
+	for node := root.FirstChild(); node != nil; node = node.NextSibling() {
+		require.Less(t, idx, len(expected))
+		start, stop := nodeRange(node, source)
+		assert.Equal(t, expected[idx].kind, node.Kind())
+		assert.Equal(t, expected[idx].value, string(source[start:stop]))
+		idx++
+	}
+	assert.Equal(t, len(expected), idx)
+}
+
+func TestDocument_Ranges(t *testing.T) {
+	data := []byte("---\ntitle: x\n---\n\n# Title\n\n1. Item\n\n   ```sh\n   echo 1\n   ```\n")
+
+	sections, err := ParseSections(data)
+	require.NoError(t, err)
+	assert.Equal(t, Position{Line: 5, Column: 1, Offset: 18}, sections.ContentStart)
+
+	doc := New(sections.Content, cmark.Render).WithBase(sections.ContentStart)
+	node, _, err := doc.Parse()
+	require.NoError(t, err)
+
+	assert.Equal(
+		t,
+		Range{
+			Start: Position{Line: 5, Column: 1, Offset: 18},
+			End:   Position{Line: 5, Column: 8, Offset: 25},
+		},
+		node.Children()[0].Item().Range(),
+	)
+
+	blocks := CollectCodeBlocks(node)
+	require.Len(t, blocks, 1)
+	assert.Equal(
+		t,
+		Range{
+			Start: Position{Line: 9, Column: 1, Offset: 36},
+			End:   Position{Line: 11, Column: 7, Offset: 61},
+		},
+		blocks[0].Range(),
+	)
+	assert.Equal(t, "   ```sh\n   echo 1\n   ```", string(data[36:61]))
+}
+
+func Test_lineIndex(t *testing.T) {
+	source := []byte("first\r\n\nthird line\nlast")
+	lines := newLineIndex(source)
+	base := Position{Line: 3, Column: 5, Offset: 20}
+	for offset := 0; offset <= len(source); offset++ {
+		assert.Equal(t, positionAt(source, offset, Position{}), lines.positionAt(offset, Position{}))
+		assert.Equal(t, positionAt(source, offset, base), lines.positionAt(offset, base))
+	}
+}
@@ -0,0 +1,101 @@
+package document
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Requires returns names of code blocks which must be executed
+// before this one. They are declared using the "requires" attribute
+// as a comma-separated list, a JSON array, or a repeated key, for example:
+//
+//	```sh { name=build requires=deps,generate }
+//
+// Names in included code blocks refer to the blocks of the same file
+// and are returned with its namespace.
+func (b *CodeBlock) Requires() []string {
+	result := parseRequires(b.attributes["requires"])
+	if b.namespace != "" {
+		for idx, name := range result {
+			result[idx] = b.namespace + "/" + name
+		}
+	}
+	return result
+}
+
+func parseRequires(value string) []string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+
+	var items []string
+	if strings.HasPrefix(value, "[") {
+		if err := json.Unmarshal([]byte(value), &items); err != nil {
+			return nil
+		}
+	} else {
+		items = strings.Split(value, ",")
+	}
+
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// ResolveRequires returns the code block with the given name preceded
+// by all code blocks it requires, directly or transitively, in the order
+// in which they should be executed. Each code block is returned once.
+// It fails if a required code block does not exist or there is a cycle.
+func (b CodeBlocks) ResolveRequires(name string) (CodeBlocks, error) {
+	var (
+		result  CodeBlocks
+		visited = make(map[string]bool)
+		path    []string
+	)
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		for idx, item := range path {
+			if item == name {
+				cycle := append(path[idx:len(path):len(path)], name)
+				return errors.Errorf("dependency cycle: %s", strings.Join(cycle, " -> "))
+			}
+		}
+
+		if visited[name] {
+			return nil
+		}
+
+		block := b.Lookup(name)
+		if block == nil {
+			if len(path) == 0 {
+				return errors.Errorf("command %q not found", name)
+			}
+			return errors.Errorf("command %q required by %q not found", name, path[len(path)-1])
+		}
+
+		path = append(path, name)
+		for _, dep := range block.Requires() {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+
+		visited[name] = true
+		result = append(result, block)
+		return nil
+	}
+
+	if err := visit(name); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
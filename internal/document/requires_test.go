@@ -0,0 +1,69 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/stateful/runme/internal/renderer/cmark"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCodeBlocks(t *testing.T, data string) CodeBlocks {
+	t.Helper()
+	doc := New([]byte(data), cmark.Render)
+	node, _, err := doc.Parse()
+	require.NoError(t, err)
+	return CollectCodeBlocks(node)
+}
+
+func TestCodeBlock_Requires(t *testing.T) {
+	blocks := testCodeBlocks(t, "```sh { name=a requires=b,c }\necho a\n```\n\n"+
+		"```sh { name=b requires=[\"c\", \"d\"] }\necho b\n```\n\n"+
+		"```sh { name=c requires=d requires=e }\necho c\n```\n\n"+
+		"```sh { name=d }\necho d\n```\n")
+
+	assert.Equal(t, []string{"b", "c"}, blocks.Lookup("a").Requires())
+	assert.Equal(t, []string{"c", "d"}, blocks.Lookup("b").Requires())
+	assert.Equal(t, []string{"d", "e"}, blocks.Lookup("c").Requires())
+	assert.Nil(t, blocks.Lookup("d").Requires())
+}
+
+func TestCodeBlocks_ResolveRequires(t *testing.T) {
+	t.Run("Order", func(t *testing.T) {
+		blocks := testCodeBlocks(t, "```sh { name=start requires=build }\necho start\n```\n\n"+
+			"```sh { name=build requires=deps,generate }\necho build\n```\n\n"+
+			"```sh { name=generate requires=deps }\necho generate\n```\n\n"+
+			"```sh { name=deps }\necho deps\n```\n")
+
+		result, err := blocks.ResolveRequires("start")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"deps", "generate", "build", "start"}, result.Names())
+
+		result, err = blocks.ResolveRequires("deps")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"deps"}, result.Names())
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		blocks := testCodeBlocks(t, "```sh { name=a requires=b }\necho a\n```\n\n"+
+			"```sh { name=b requires=c }\necho b\n```\n\n"+
+			"```sh { name=c requires=a }\necho c\n```\n")
+
+		_, err := blocks.ResolveRequires("a")
+		assert.EqualError(t, err, "dependency cycle: a -> b -> c -> a")
+	})
+
+	t.Run("SelfCycle", func(t *testing.T) {
+		blocks := testCodeBlocks(t, "```sh { name=a requires=a }\necho a\n```\n")
+
+		_, err := blocks.ResolveRequires("a")
+		assert.EqualError(t, err, "dependency cycle: a -> a")
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		blocks := testCodeBlocks(t, "```sh { name=a requires=b }\necho a\n```\n")
+
+		_, err := blocks.ResolveRequires("a")
+		assert.EqualError(t, err, `command "b" required by "a" not found`)
+	})
+}
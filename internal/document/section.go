@@ -0,0 +1,148 @@
+package document
+
+import (
+	"path"
+	"strings"
+)
+
+// SectionSeparator separates titles of nested headings in a section path.
+// Separators and backslashes in titles are escaped with a backslash,
+// for example, the heading "CI/CD" is "CI\/CD" in a section path.
+const SectionSeparator = "/"
+
+// Sections returns titles of the headings enclosing the code block,
+// starting from the outermost one. Code blocks of an included file
+// are nested in the section of the include directive.
+func (b *CodeBlock) Sections() []string {
+	return b.sections
+}
+
+// Section returns the path of the section the code block belongs to,
+// for example, "Deploy/Staging". It is empty if there is no enclosing heading.
+func (b *CodeBlock) Section() string {
+	titles := make([]string, 0, len(b.sections))
+	for _, title := range b.sections {
+		titles = append(titles, sectionEscaper.Replace(title))
+	}
+	return strings.Join(titles, SectionSeparator)
+}
+
+var sectionEscaper = strings.NewReplacer(`\`, `\\`, SectionSeparator, `\`+SectionSeparator)
+
+// Match returns code blocks matching the pattern. The pattern syntax is
+// the one of path.Match and it is matched against the name of a code
+// block prefixed with its section, for example, "Deploy/Staging/push",
+// as well as against the section alone and its parent sections.
+// Hence, "Deploy/*" matches all code blocks under the "Deploy" heading.
+// Like in Section, a separator in a title is escaped, for example,
+// "CI\/CD/*" matches code blocks under the "CI/CD" heading.
+func (b CodeBlocks) Match(pattern string) (result CodeBlocks, _ error) {
+	segments := splitSectionPattern(pattern)
+	for _, segment := range segments {
+		if _, err := path.Match(segment, ""); err != nil {
+			return nil, err
+		}
+	}
+	for _, block := range b {
+		if block.match(segments) {
+			result = append(result, block)
+		}
+	}
+	return result, nil
+}
+
+// match reports whether the pattern segments match the titles of
+// the enclosing headings followed by the name of the code block.
+// Namespaces of included code blocks are separate segments.
+func (b *CodeBlock) match(pattern []string) bool {
+	segments := make([]string, 0, len(b.sections)+1)
+	for _, title := range b.sections {
+		// Separators in titles are matched by escaped separators
+		// in the pattern, see splitSectionPattern.
+		segments = append(segments, strings.ReplaceAll(title, SectionSeparator, "\x00"))
+	}
+	segments = append(segments, strings.Split(b.name, SectionSeparator)...)
+
+	if len(pattern) > len(segments) {
+		return false
+	}
+	for i, segment := range pattern {
+		if ok, _ := path.Match(segment, segments[i]); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// splitSectionPattern splits the pattern at separators which are not
+// escaped. Escaped separators are replaced by "\x00" so that a pattern
+// segment can be matched against a single title.
+func splitSectionPattern(pattern string) []string {
+	var (
+		segments []string
+		b        strings.Builder
+	)
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\' && i+1 < len(pattern):
+			i++
+			if pattern[i] == SectionSeparator[0] {
+				_ = b.WriteByte(0)
+			} else {
+				_ = b.WriteByte(c)
+				_ = b.WriteByte(pattern[i])
+			}
+		case c == SectionSeparator[0]:
+			segments = append(segments, b.String())
+			b.Reset()
+		default:
+			_ = b.WriteByte(c)
+		}
+	}
+	return append(segments, b.String())
+}
+
+// IsPattern reports whether name contains any of the special
+// characters recognized by CodeBlocks.Match.
+func IsPattern(name string) bool {
+	return strings.ContainsAny(name, `*?[\`)
+}
+
+type heading struct {
+	level int
+	title string
+}
+
+// headings is a stack of headings enclosing the current position
+// in a document.
+type headings []heading
+
+func (h headings) push(level int, title string) headings {
+	idx := len(h)
+	for idx > 0 && h[idx-1].level >= level {
+		idx--
+	}
+	return append(h[:idx:idx], heading{level: level, title: title})
+}
+
+// enclose returns headings which are never popped by push.
+// It is used for included documents whose headings levels
+// are unrelated to the ones of the including document.
+func (h headings) enclose() headings {
+	result := make(headings, 0, len(h))
+	for _, item := range h {
+		result = append(result, heading{title: item.title})
+	}
+	return result
+}
+
+func (h headings) titles() []string {
+	if len(h) == 0 {
+		return nil
+	}
+	result := make([]string, 0, len(h))
+	for _, item := range h {
+		result = append(result, item.title)
+	}
+	return result
+}
@@ -0,0 +1,113 @@
+package document
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeBlock_Sections(t *testing.T) {
+	blocks := testCodeBlocks(t, "```sh { name=intro }\necho intro\n```\n\n"+
+		"# Deploy\n\n```sh { name=build }\necho build\n```\n\n"+
+		"## Staging\n\n```sh { name=push-staging }\necho staging\n```\n\n"+
+		"### Checks\n\n```sh { name=check }\necho check\n```\n\n"+
+		"## Production\n\n```sh { name=push-prod }\necho prod\n```\n\n"+
+		"Cleanup\n=======\n\n> ```sh { name=cleanup }\n> echo cleanup\n> ```\n")
+
+	assert.Nil(t, blocks.Lookup("intro").Sections())
+	assert.Equal(t, "", blocks.Lookup("intro").Section())
+	assert.Equal(t, "Deploy", blocks.Lookup("build").Section())
+	assert.Equal(t, "Deploy/Staging", blocks.Lookup("push-staging").Section())
+	assert.Equal(t, []string{"Deploy", "Staging", "Checks"}, blocks.Lookup("check").Sections())
+	assert.Equal(t, "Deploy/Production", blocks.Lookup("push-prod").Section())
+	assert.Equal(t, "Cleanup", blocks.Lookup("cleanup").Section())
+}
+
+func TestCodeBlocks_Match(t *testing.T) {
+	blocks := testCodeBlocks(t, "```sh { name=intro }\necho intro\n```\n\n"+
+		"# Deploy\n\n```sh { name=build }\necho build\n```\n\n"+
+		"## Staging\n\n```sh { name=push-staging }\necho staging\n```\n\n"+
+		"# Test\n\n```sh { name=unit }\necho unit\n```\n")
+
+	testCases := []struct {
+		pattern  string
+		expected []string
+	}{
+		{"Deploy/*", []string{"build", "push-staging"}},
+		{"Deploy/Staging/*", []string{"push-staging"}},
+		{"*/*/push-*", []string{"push-staging"}},
+		{"*", []string{"intro", "build", "push-staging", "unit"}},
+		{"Missing/*", nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.pattern, func(t *testing.T) {
+			result, err := blocks.Match(tc.pattern)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result.Names())
+		})
+	}
+
+	_, err := blocks.Match("[")
+	assert.Error(t, err)
+}
+
+func TestCodeBlocks_MatchSeparatorInTitle(t *testing.T) {
+	blocks := testCodeBlocks(t, "# CI/CD\n\n```sh { name=pipeline }\necho pipeline\n```\n\n"+
+		"# CI\n\n## CD\n\n```sh { name=nested }\necho nested\n```\n\n"+
+		"# C:\\Temp\n\n```sh { name=clean }\necho clean\n```\n")
+
+	assert.Equal(t, []string{"CI/CD"}, blocks.Lookup("pipeline").Sections())
+	assert.Equal(t, `CI\/CD`, blocks.Lookup("pipeline").Section())
+	assert.Equal(t, "CI/CD", blocks.Lookup("nested").Section())
+	assert.Equal(t, `C:\\Temp`, blocks.Lookup("clean").Section())
+
+	testCases := []struct {
+		pattern  string
+		expected []string
+	}{
+		{`CI\/CD/*`, []string{"pipeline"}},
+		{`CI\/CD`, []string{"pipeline"}},
+		{"CI/CD/*", []string{"nested"}},
+		{"CI/*", []string{"nested"}},
+		{"CI*", []string{"pipeline", "nested"}},
+		{`C:\\Temp/*`, []string{"clean"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.pattern, func(t *testing.T) {
+			result, err := blocks.Match(tc.pattern)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result.Names())
+		})
+	}
+}
+
+func TestCodeBlock_SectionsIncluded(t *testing.T) {
+	fsys := fstest.MapFS{
+		"README.md": {Data: []byte("# Service\n\n## Setup\n\n```runme-include { namespace= }\nsetup.md\n```\n\n## Run\n\n```sh { name=start }\necho start\n```\n")},
+		"setup.md":  {Data: []byte("```sh { name=deps }\necho deps\n```\n\n# Database\n\n```sh { name=migrate }\necho migrate\n```\n")},
+	}
+
+	blocks, err := testIncludeBlocks(fsys, "README.md")
+	require.NoError(t, err)
+	assert.Equal(t, "Service/Setup", blocks.Lookup("deps").Section())
+	assert.Equal(t, "Service/Setup/Database", blocks.Lookup("migrate").Section())
+	assert.Equal(t, "Service/Run", blocks.Lookup("start").Section())
+
+	// Namespaces are matched like sections.
+	fsys["README.md"] = &fstest.MapFile{Data: []byte("# Service\n\n```runme-include { namespace=db }\nsetup.md\n```\n")}
+	blocks, err = testIncludeBlocks(fsys, "README.md")
+	require.NoError(t, err)
+	result, err := blocks.Match("Service/db/*")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"db/deps"}, result.Names())
+}
+
+func TestIsPattern(t *testing.T) {
+	assert.True(t, IsPattern("Deploy/*"))
+	assert.True(t, IsPattern("build-?"))
+	assert.False(t, IsPattern("setup/db-migrate"))
+}
@@ -0,0 +1,65 @@
+package document
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultRetryDelay is the delay of the first retry
+// of a code block without the "retry-delay" attribute.
+const DefaultRetryDelay = time.Second
+
+// Timeout returns the duration after which the code block is stopped,
+// set with the "timeout" attribute, for example:
+//
+//	```sh { name=wait timeout=5m }
+//
+// A number without a unit is in seconds. Zero means no timeout.
+func (b *CodeBlock) Timeout() (time.Duration, error) {
+	return parseDurationAttribute(b.attributes, "timeout", 0)
+}
+
+// Retries returns how many times the code block is run again
+// if it fails, set with the "retries" attribute.
+func (b *CodeBlock) Retries() (int, error) {
+	value := strings.TrimSpace(b.attributes["retries"])
+	if value == "" {
+		return 0, nil
+	}
+	retries, err := strconv.Atoi(value)
+	if err != nil || retries < 0 {
+		return 0, errors.Errorf("invalid retries %q: expected a non-negative integer", value)
+	}
+	return retries, nil
+}
+
+// RetryDelay returns the delay of the first retry of the code block,
+// set with the "retry-delay" attribute. Subsequent retries are
+// delayed twice as long as the previous ones.
+func (b *CodeBlock) RetryDelay() (time.Duration, error) {
+	return parseDurationAttribute(b.attributes, "retry-delay", DefaultRetryDelay)
+}
+
+func parseDurationAttribute(attributes map[string]string, name string, defaultValue time.Duration) (time.Duration, error) {
+	value := strings.TrimSpace(attributes[name])
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	result, err := time.ParseDuration(value)
+	if err != nil {
+		seconds, errSeconds := strconv.ParseFloat(value, 64)
+		if errSeconds != nil {
+			return 0, errors.Errorf("invalid %s %q: expected a duration like 30s or 5m", name, value)
+		}
+		result = time.Duration(seconds * float64(time.Second))
+	}
+
+	if result < 0 {
+		return 0, errors.Errorf("invalid %s %q: expected a non-negative duration", name, value)
+	}
+	return result, nil
+}
@@ -0,0 +1,54 @@
+package document
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeBlock_Timeout(t *testing.T) {
+	blocks := testCodeBlocks(t, "```sh { name=wait timeout=5m retries=3 retry-delay=500ms }\nkubectl wait\n```\n\n"+
+		"```sh { name=seconds timeout=1.5 retry-delay=0 }\nsleep 1\n```\n\n"+
+		"```sh { name=default }\necho default\n```\n\n"+
+		"```sh { name=invalid timeout=soon retries=-1 retry-delay=-1s }\necho invalid\n```\n")
+
+	wait := blocks.Lookup("wait")
+	timeout, err := wait.Timeout()
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, timeout)
+	retries, err := wait.Retries()
+	require.NoError(t, err)
+	assert.Equal(t, 3, retries)
+	delay, err := wait.RetryDelay()
+	require.NoError(t, err)
+	assert.Equal(t, 500*time.Millisecond, delay)
+
+	seconds := blocks.Lookup("seconds")
+	timeout, err = seconds.Timeout()
+	require.NoError(t, err)
+	assert.Equal(t, 1500*time.Millisecond, timeout)
+	delay, err = seconds.RetryDelay()
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), delay)
+
+	def := blocks.Lookup("default")
+	timeout, err = def.Timeout()
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), timeout)
+	retries, err = def.Retries()
+	require.NoError(t, err)
+	assert.Equal(t, 0, retries)
+	delay, err = def.RetryDelay()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultRetryDelay, delay)
+
+	invalid := blocks.Lookup("invalid")
+	_, err = invalid.Timeout()
+	assert.EqualError(t, err, `invalid timeout "soon": expected a duration like 30s or 5m`)
+	_, err = invalid.Retries()
+	assert.EqualError(t, err, `invalid retries "-1": expected a non-negative integer`)
+	_, err = invalid.RetryDelay()
+	assert.EqualError(t, err, `invalid retry-delay "-1s": expected a non-negative duration`)
+}
@@ -0,0 +1,190 @@
+package document
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Variable is a variable a shell code block depends on.
+type Variable struct {
+	Name string
+	// Placeholder is a value like "<your-token>" assigned to the variable
+	// in the code block, which needs to be replaced before execution.
+	// It is empty for variables which are only referenced.
+	Placeholder string
+}
+
+var (
+	placeholderAssignmentRe = regexp.MustCompile(`^(\s*(?:export\s+)?([A-Za-z_][A-Za-z0-9_]*)=)(["']?)(<[^<>]+>)(["']?)(\s*)$`)
+	assignmentRe            = regexp.MustCompile(`(?:^|[;&|(]|\bexport|\blocal|\bdeclare|\breadonly)\s*([A-Za-z_][A-Za-z0-9_]*)=`)
+	// Options of read like -d, -n, -p, -t and -u take an argument,
+	// for example, read -r -p "Name: " NAME.
+	loopOrReadRe = regexp.MustCompile(`\b(?:for\s+([A-Za-z_][A-Za-z0-9_]*)\s+in|read(?:\s+(?:-[A-Za-z]*[dinNptu]\s*(?:"[^"]*"|'[^']*'|[^\s"']+)|-[A-Za-z]+))*((?:\s+[A-Za-z_][A-Za-z0-9_]*)+))`)
+	referenceRe  = regexp.MustCompile(`\$(?:\{([A-Za-z_][A-Za-z0-9_]*)([^}]*)\}|([A-Za-z_][A-Za-z0-9_]*))`)
+)
+
+// shellVariables are variables set by shells, like $RANDOM or $UID,
+// which code blocks do not depend on even if they do not assign them.
+var shellVariables = map[string]bool{
+	"BASH": true, "BASHOPTS": true, "BASHPID": true, "DIRSTACK": true,
+	"EPOCHREALTIME": true, "EPOCHSECONDS": true, "EUID": true, "FUNCNAME": true,
+	"GROUPS": true, "HISTCMD": true, "HOSTNAME": true, "HOSTTYPE": true,
+	"IFS": true, "LINENO": true, "MACHTYPE": true, "OLDPWD": true,
+	"OPTARG": true, "OPTIND": true, "OSTYPE": true, "PIPESTATUS": true,
+	"PPID": true, "PWD": true, "RANDOM": true, "REPLY": true,
+	"SECONDS": true, "SHELLOPTS": true, "SHLVL": true, "SRANDOM": true,
+	"UID": true,
+}
+
+func isShellVariable(name string) bool {
+	return shellVariables[name] || strings.HasPrefix(name, "BASH_") || strings.HasPrefix(name, "ZSH_")
+}
+
+// Variables returns variables the code block depends on in the order
+// of their first occurrence. These are variables with a placeholder
+// value, for example:
+//
+//	export TOKEN=<your-token>
+//
+// and variables referenced as $NAME or ${NAME} which are not assigned
+// in the code block before. References with a default value, like
+// ${NAME:-default}, the ones in single quotes, and variables set
+// by shells, like $RANDOM, are ignored.
+// The result is meaningful only for shell code blocks.
+func (b *CodeBlock) Variables() []Variable {
+	return parseVariables(b.Lines())
+}
+
+func parseVariables(lines []string) (result []Variable) {
+	var (
+		assigned = make(map[string]bool)
+		seen     = make(map[string]bool)
+	)
+
+	add := func(v Variable) {
+		if seen[v.Name] {
+			return
+		}
+		seen[v.Name] = true
+		result = append(result, v)
+	}
+
+	for _, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+
+		if m := placeholderAssignmentRe.FindStringSubmatch(line); m != nil {
+			add(Variable{Name: m[2], Placeholder: m[4]})
+			assigned[m[2]] = true
+			continue
+		}
+
+		line = stripSingleQuoted(line)
+
+		// Assignments and references are processed in the order
+		// of their occurrence, so that "A=1; echo $A" is not reported.
+		type event struct {
+			pos       int
+			name      string
+			reference bool
+		}
+		var events []event
+
+		for _, m := range referenceRe.FindAllStringSubmatchIndex(line, -1) {
+			// Skip references with a modifier like ${NAME:-default}.
+			if m[4] != m[5] {
+				continue
+			}
+			var name string
+			if m[2] != -1 {
+				name = line[m[2]:m[3]]
+			} else {
+				name = line[m[6]:m[7]]
+			}
+			events = append(events, event{pos: m[0], name: name, reference: true})
+		}
+		for _, m := range assignmentRe.FindAllStringSubmatchIndex(line, -1) {
+			events = append(events, event{pos: m[2], name: line[m[2]:m[3]]})
+		}
+		for _, m := range loopOrReadRe.FindAllStringSubmatchIndex(line, -1) {
+			for _, group := range [][]int{m[2:4], m[4:6]} {
+				if group[0] == -1 {
+					continue
+				}
+				for _, name := range strings.Fields(line[group[0]:group[1]]) {
+					events = append(events, event{pos: group[0], name: name})
+				}
+			}
+		}
+
+		sort.SliceStable(events, func(i, j int) bool { return events[i].pos < events[j].pos })
+
+		for _, e := range events {
+			if !e.reference {
+				assigned[e.name] = true
+			} else if !assigned[e.name] && !isShellVariable(e.name) {
+				add(Variable{Name: e.name})
+			}
+		}
+	}
+
+	return result
+}
+
+// stripSingleQuoted removes strings in single quotes and escaped
+// dollar signs as variables are not expanded in them.
+func stripSingleQuoted(line string) string {
+	var (
+		b      strings.Builder
+		quoted bool
+		double bool
+	)
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == '\\' && !quoted && i+1 < len(line):
+			i++
+			if line[i] != '$' {
+				_ = b.WriteByte(c)
+				_ = b.WriteByte(line[i])
+			}
+			continue
+		case c == '\'' && !double:
+			quoted = !quoted
+			continue
+		case c == '"' && !quoted:
+			double = !double
+		}
+		if !quoted {
+			_ = b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// ReplacePlaceholders replaces placeholder values of variables,
+// as returned by CodeBlock.Variables, with the provided values.
+// Lines are modified in place. Placeholders of variables without
+// a value are left intact.
+func ReplacePlaceholders(lines []string, values map[string]string) {
+	for idx, line := range lines {
+		m := placeholderAssignmentRe.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		value, ok := values[m[2]]
+		if !ok {
+			continue
+		}
+		lines[idx] = m[1] + quoteShell(value) + m[6]
+	}
+}
+
+func quoteShell(value string) string {
+	if value != "" && !strings.ContainsAny(value, " \t\r\n\"'`$\\|&;<>()*?[]{}~#!") {
+		return value
+	}
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
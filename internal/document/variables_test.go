@@ -0,0 +1,61 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVariables(t *testing.T) {
+	lines := []string{
+		"# Configure $COMMENTED",
+		"export TOKEN=<your-token>",
+		`REGION="<region>"`,
+		"gcloud config set project $PROJECT_ID",
+		`echo "${TOKEN} ${DEBUG:-false} $1 $@"`,
+		"NAME=runme; echo $NAME",
+		"for f in *.md; do echo $f; done",
+		"read -r ANSWER && echo $ANSWER",
+		`echo '$LITERAL' "$QUOTED" \$ESCAPED`,
+		"echo ${PROJECT_ID}/${REGION}",
+		`read -r -p "Your name: " USERNAME && echo $USERNAME`,
+		"read -rp 'Continue? ' -t 5 -n1 REPLY_1; echo $REPLY_1",
+		"echo $RANDOM $UID $PPID $LINENO $SECONDS ${BASH_SOURCE} $BASH_VERSION",
+	}
+
+	assert.Equal(
+		t,
+		[]Variable{
+			{Name: "TOKEN", Placeholder: "<your-token>"},
+			{Name: "REGION", Placeholder: "<region>"},
+			{Name: "PROJECT_ID"},
+			{Name: "QUOTED"},
+		},
+		parseVariables(lines),
+	)
+}
+
+func TestReplacePlaceholders(t *testing.T) {
+	lines := []string{
+		"export TOKEN=<your-token>",
+		`REGION="<region>"`,
+		"export USER=<user>",
+		"echo $TOKEN",
+	}
+
+	ReplacePlaceholders(lines, map[string]string{
+		"TOKEN":  "abc123",
+		"REGION": "eu west's",
+	})
+
+	assert.Equal(
+		t,
+		[]string{
+			"export TOKEN=abc123",
+			`REGION='eu west'\''s'`,
+			"export USER=<user>",
+			"echo $TOKEN",
+		},
+		lines,
+	)
+}
@@ -24,6 +24,19 @@ const _ = grpc.SupportPackageIsVersion7
 type ParserServiceClient interface {
 	Deserialize(ctx context.Context, in *DeserializeRequest, opts ...grpc.CallOption) (*DeserializeResponse, error)
 	Serialize(ctx context.Context, in *SerializeRequest, opts ...grpc.CallOption) (*SerializeResponse, error)
+	// FromJupyter converts a Jupyter notebook into a notebook
+	// which can be serialized with Serialize.
+	FromJupyter(ctx context.Context, in *FromJupyterRequest, opts ...grpc.CallOption) (*FromJupyterResponse, error)
+	// ToJupyter converts a notebook into a Jupyter notebook.
+	ToJupyter(ctx context.Context, in *ToJupyterRequest, opts ...grpc.CallOption) (*ToJupyterResponse, error)
+	// Cell operations edit a single document without serializing
+	// the whole notebook. They return the minimal text edit.
+	InsertCell(ctx context.Context, in *InsertCellRequest, opts ...grpc.CallOption) (*InsertCellResponse, error)
+	UpdateCell(ctx context.Context, in *UpdateCellRequest, opts ...grpc.CallOption) (*UpdateCellResponse, error)
+	DeleteCell(ctx context.Context, in *DeleteCellRequest, opts ...grpc.CallOption) (*DeleteCellResponse, error)
+	MoveCell(ctx context.Context, in *MoveCellRequest, opts ...grpc.CallOption) (*MoveCellResponse, error)
+	SplitCell(ctx context.Context, in *SplitCellRequest, opts ...grpc.CallOption) (*SplitCellResponse, error)
+	MergeCells(ctx context.Context, in *MergeCellsRequest, opts ...grpc.CallOption) (*MergeCellsResponse, error)
 }
 
 type parserServiceClient struct {
@@ -52,12 +65,97 @@ func (c *parserServiceClient) Serialize(ctx context.Context, in *SerializeReques
 	return out, nil
 }
 
+func (c *parserServiceClient) FromJupyter(ctx context.Context, in *FromJupyterRequest, opts ...grpc.CallOption) (*FromJupyterResponse, error) {
+	out := new(FromJupyterResponse)
+	err := c.cc.Invoke(ctx, "/runme.parser.v1.ParserService/FromJupyter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *parserServiceClient) ToJupyter(ctx context.Context, in *ToJupyterRequest, opts ...grpc.CallOption) (*ToJupyterResponse, error) {
+	out := new(ToJupyterResponse)
+	err := c.cc.Invoke(ctx, "/runme.parser.v1.ParserService/ToJupyter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *parserServiceClient) InsertCell(ctx context.Context, in *InsertCellRequest, opts ...grpc.CallOption) (*InsertCellResponse, error) {
+	out := new(InsertCellResponse)
+	err := c.cc.Invoke(ctx, "/runme.parser.v1.ParserService/InsertCell", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *parserServiceClient) UpdateCell(ctx context.Context, in *UpdateCellRequest, opts ...grpc.CallOption) (*UpdateCellResponse, error) {
+	out := new(UpdateCellResponse)
+	err := c.cc.Invoke(ctx, "/runme.parser.v1.ParserService/UpdateCell", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *parserServiceClient) DeleteCell(ctx context.Context, in *DeleteCellRequest, opts ...grpc.CallOption) (*DeleteCellResponse, error) {
+	out := new(DeleteCellResponse)
+	err := c.cc.Invoke(ctx, "/runme.parser.v1.ParserService/DeleteCell", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *parserServiceClient) MoveCell(ctx context.Context, in *MoveCellRequest, opts ...grpc.CallOption) (*MoveCellResponse, error) {
+	out := new(MoveCellResponse)
+	err := c.cc.Invoke(ctx, "/runme.parser.v1.ParserService/MoveCell", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *parserServiceClient) SplitCell(ctx context.Context, in *SplitCellRequest, opts ...grpc.CallOption) (*SplitCellResponse, error) {
+	out := new(SplitCellResponse)
+	err := c.cc.Invoke(ctx, "/runme.parser.v1.ParserService/SplitCell", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *parserServiceClient) MergeCells(ctx context.Context, in *MergeCellsRequest, opts ...grpc.CallOption) (*MergeCellsResponse, error) {
+	out := new(MergeCellsResponse)
+	err := c.cc.Invoke(ctx, "/runme.parser.v1.ParserService/MergeCells", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ParserServiceServer is the server API for ParserService service.
 // All implementations must embed UnimplementedParserServiceServer
 // for forward compatibility
 type ParserServiceServer interface {
 	Deserialize(context.Context, *DeserializeRequest) (*DeserializeResponse, error)
 	Serialize(context.Context, *SerializeRequest) (*SerializeResponse, error)
+	// FromJupyter converts a Jupyter notebook into a notebook
+	// which can be serialized with Serialize.
+	FromJupyter(context.Context, *FromJupyterRequest) (*FromJupyterResponse, error)
+	// ToJupyter converts a notebook into a Jupyter notebook.
+	ToJupyter(context.Context, *ToJupyterRequest) (*ToJupyterResponse, error)
+	// Cell operations edit a single document without serializing
+	// the whole notebook. They return the minimal text edit.
+	InsertCell(context.Context, *InsertCellRequest) (*InsertCellResponse, error)
+	UpdateCell(context.Context, *UpdateCellRequest) (*UpdateCellResponse, error)
+	DeleteCell(context.Context, *DeleteCellRequest) (*DeleteCellResponse, error)
+	MoveCell(context.Context, *MoveCellRequest) (*MoveCellResponse, error)
+	SplitCell(context.Context, *SplitCellRequest) (*SplitCellResponse, error)
+	MergeCells(context.Context, *MergeCellsRequest) (*MergeCellsResponse, error)
 	mustEmbedUnimplementedParserServiceServer()
 }
 
@@ -71,6 +169,30 @@ func (UnimplementedParserServiceServer) Deserialize(context.Context, *Deserializ
 func (UnimplementedParserServiceServer) Serialize(context.Context, *SerializeRequest) (*SerializeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Serialize not implemented")
 }
+func (UnimplementedParserServiceServer) FromJupyter(context.Context, *FromJupyterRequest) (*FromJupyterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FromJupyter not implemented")
+}
+func (UnimplementedParserServiceServer) ToJupyter(context.Context, *ToJupyterRequest) (*ToJupyterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ToJupyter not implemented")
+}
+func (UnimplementedParserServiceServer) InsertCell(context.Context, *InsertCellRequest) (*InsertCellResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertCell not implemented")
+}
+func (UnimplementedParserServiceServer) UpdateCell(context.Context, *UpdateCellRequest) (*UpdateCellResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCell not implemented")
+}
+func (UnimplementedParserServiceServer) DeleteCell(context.Context, *DeleteCellRequest) (*DeleteCellResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCell not implemented")
+}
+func (UnimplementedParserServiceServer) MoveCell(context.Context, *MoveCellRequest) (*MoveCellResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCell not implemented")
+}
+func (UnimplementedParserServiceServer) SplitCell(context.Context, *SplitCellRequest) (*SplitCellResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitCell not implemented")
+}
+func (UnimplementedParserServiceServer) MergeCells(context.Context, *MergeCellsRequest) (*MergeCellsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeCells not implemented")
+}
 func (UnimplementedParserServiceServer) mustEmbedUnimplementedParserServiceServer() {}
 
 // UnsafeParserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -120,6 +242,150 @@ func _ParserService_Serialize_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ParserService_FromJupyter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FromJupyterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParserServiceServer).FromJupyter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/runme.parser.v1.ParserService/FromJupyter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParserServiceServer).FromJupyter(ctx, req.(*FromJupyterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ParserService_ToJupyter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ToJupyterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParserServiceServer).ToJupyter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/runme.parser.v1.ParserService/ToJupyter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParserServiceServer).ToJupyter(ctx, req.(*ToJupyterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ParserService_InsertCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParserServiceServer).InsertCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/runme.parser.v1.ParserService/InsertCell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParserServiceServer).InsertCell(ctx, req.(*InsertCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ParserService_UpdateCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParserServiceServer).UpdateCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/runme.parser.v1.ParserService/UpdateCell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParserServiceServer).UpdateCell(ctx, req.(*UpdateCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ParserService_DeleteCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParserServiceServer).DeleteCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/runme.parser.v1.ParserService/DeleteCell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParserServiceServer).DeleteCell(ctx, req.(*DeleteCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ParserService_MoveCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParserServiceServer).MoveCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/runme.parser.v1.ParserService/MoveCell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParserServiceServer).MoveCell(ctx, req.(*MoveCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ParserService_SplitCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParserServiceServer).SplitCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/runme.parser.v1.ParserService/SplitCell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParserServiceServer).SplitCell(ctx, req.(*SplitCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ParserService_MergeCells_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCellsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParserServiceServer).MergeCells(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/runme.parser.v1.ParserService/MergeCells",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParserServiceServer).MergeCells(ctx, req.(*MergeCellsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ParserService_ServiceDesc is the grpc.ServiceDesc for ParserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -135,6 +401,38 @@ var ParserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Serialize",
 			Handler:    _ParserService_Serialize_Handler,
 		},
+		{
+			MethodName: "FromJupyter",
+			Handler:    _ParserService_FromJupyter_Handler,
+		},
+		{
+			MethodName: "ToJupyter",
+			Handler:    _ParserService_ToJupyter_Handler,
+		},
+		{
+			MethodName: "InsertCell",
+			Handler:    _ParserService_InsertCell_Handler,
+		},
+		{
+			MethodName: "UpdateCell",
+			Handler:    _ParserService_UpdateCell_Handler,
+		},
+		{
+			MethodName: "DeleteCell",
+			Handler:    _ParserService_DeleteCell_Handler,
+		},
+		{
+			MethodName: "MoveCell",
+			Handler:    _ParserService_MoveCell_Handler,
+		},
+		{
+			MethodName: "SplitCell",
+			Handler:    _ParserService_SplitCell_Handler,
+		},
+		{
+			MethodName: "MergeCells",
+			Handler:    _ParserService_MergeCells_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "runme/parser/v1/parser.proto",
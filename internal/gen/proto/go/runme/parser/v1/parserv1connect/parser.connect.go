@@ -29,6 +29,19 @@ const (
 type ParserServiceClient interface {
 	Deserialize(context.Context, *connect_go.Request[v1.DeserializeRequest]) (*connect_go.Response[v1.DeserializeResponse], error)
 	Serialize(context.Context, *connect_go.Request[v1.SerializeRequest]) (*connect_go.Response[v1.SerializeResponse], error)
+	// FromJupyter converts a Jupyter notebook into a notebook
+	// which can be serialized with Serialize.
+	FromJupyter(context.Context, *connect_go.Request[v1.FromJupyterRequest]) (*connect_go.Response[v1.FromJupyterResponse], error)
+	// ToJupyter converts a notebook into a Jupyter notebook.
+	ToJupyter(context.Context, *connect_go.Request[v1.ToJupyterRequest]) (*connect_go.Response[v1.ToJupyterResponse], error)
+	// Cell operations edit a single document without serializing
+	// the whole notebook. They return the minimal text edit.
+	InsertCell(context.Context, *connect_go.Request[v1.InsertCellRequest]) (*connect_go.Response[v1.InsertCellResponse], error)
+	UpdateCell(context.Context, *connect_go.Request[v1.UpdateCellRequest]) (*connect_go.Response[v1.UpdateCellResponse], error)
+	DeleteCell(context.Context, *connect_go.Request[v1.DeleteCellRequest]) (*connect_go.Response[v1.DeleteCellResponse], error)
+	MoveCell(context.Context, *connect_go.Request[v1.MoveCellRequest]) (*connect_go.Response[v1.MoveCellResponse], error)
+	SplitCell(context.Context, *connect_go.Request[v1.SplitCellRequest]) (*connect_go.Response[v1.SplitCellResponse], error)
+	MergeCells(context.Context, *connect_go.Request[v1.MergeCellsRequest]) (*connect_go.Response[v1.MergeCellsResponse], error)
 }
 
 // NewParserServiceClient constructs a client for the runme.parser.v1.ParserService service. By
@@ -51,6 +64,46 @@ func NewParserServiceClient(httpClient connect_go.HTTPClient, baseURL string, op
 			baseURL+"/runme.parser.v1.ParserService/Serialize",
 			opts...,
 		),
+		fromJupyter: connect_go.NewClient[v1.FromJupyterRequest, v1.FromJupyterResponse](
+			httpClient,
+			baseURL+"/runme.parser.v1.ParserService/FromJupyter",
+			opts...,
+		),
+		toJupyter: connect_go.NewClient[v1.ToJupyterRequest, v1.ToJupyterResponse](
+			httpClient,
+			baseURL+"/runme.parser.v1.ParserService/ToJupyter",
+			opts...,
+		),
+		insertCell: connect_go.NewClient[v1.InsertCellRequest, v1.InsertCellResponse](
+			httpClient,
+			baseURL+"/runme.parser.v1.ParserService/InsertCell",
+			opts...,
+		),
+		updateCell: connect_go.NewClient[v1.UpdateCellRequest, v1.UpdateCellResponse](
+			httpClient,
+			baseURL+"/runme.parser.v1.ParserService/UpdateCell",
+			opts...,
+		),
+		deleteCell: connect_go.NewClient[v1.DeleteCellRequest, v1.DeleteCellResponse](
+			httpClient,
+			baseURL+"/runme.parser.v1.ParserService/DeleteCell",
+			opts...,
+		),
+		moveCell: connect_go.NewClient[v1.MoveCellRequest, v1.MoveCellResponse](
+			httpClient,
+			baseURL+"/runme.parser.v1.ParserService/MoveCell",
+			opts...,
+		),
+		splitCell: connect_go.NewClient[v1.SplitCellRequest, v1.SplitCellResponse](
+			httpClient,
+			baseURL+"/runme.parser.v1.ParserService/SplitCell",
+			opts...,
+		),
+		mergeCells: connect_go.NewClient[v1.MergeCellsRequest, v1.MergeCellsResponse](
+			httpClient,
+			baseURL+"/runme.parser.v1.ParserService/MergeCells",
+			opts...,
+		),
 	}
 }
 
@@ -58,6 +111,14 @@ func NewParserServiceClient(httpClient connect_go.HTTPClient, baseURL string, op
 type parserServiceClient struct {
 	deserialize *connect_go.Client[v1.DeserializeRequest, v1.DeserializeResponse]
 	serialize   *connect_go.Client[v1.SerializeRequest, v1.SerializeResponse]
+	fromJupyter *connect_go.Client[v1.FromJupyterRequest, v1.FromJupyterResponse]
+	toJupyter   *connect_go.Client[v1.ToJupyterRequest, v1.ToJupyterResponse]
+	insertCell  *connect_go.Client[v1.InsertCellRequest, v1.InsertCellResponse]
+	updateCell  *connect_go.Client[v1.UpdateCellRequest, v1.UpdateCellResponse]
+	deleteCell  *connect_go.Client[v1.DeleteCellRequest, v1.DeleteCellResponse]
+	moveCell    *connect_go.Client[v1.MoveCellRequest, v1.MoveCellResponse]
+	splitCell   *connect_go.Client[v1.SplitCellRequest, v1.SplitCellResponse]
+	mergeCells  *connect_go.Client[v1.MergeCellsRequest, v1.MergeCellsResponse]
 }
 
 // Deserialize calls runme.parser.v1.ParserService.Deserialize.
@@ -70,10 +131,63 @@ func (c *parserServiceClient) Serialize(ctx context.Context, req *connect_go.Req
 	return c.serialize.CallUnary(ctx, req)
 }
 
+// FromJupyter calls runme.parser.v1.ParserService.FromJupyter.
+func (c *parserServiceClient) FromJupyter(ctx context.Context, req *connect_go.Request[v1.FromJupyterRequest]) (*connect_go.Response[v1.FromJupyterResponse], error) {
+	return c.fromJupyter.CallUnary(ctx, req)
+}
+
+// ToJupyter calls runme.parser.v1.ParserService.ToJupyter.
+func (c *parserServiceClient) ToJupyter(ctx context.Context, req *connect_go.Request[v1.ToJupyterRequest]) (*connect_go.Response[v1.ToJupyterResponse], error) {
+	return c.toJupyter.CallUnary(ctx, req)
+}
+
+// InsertCell calls runme.parser.v1.ParserService.InsertCell.
+func (c *parserServiceClient) InsertCell(ctx context.Context, req *connect_go.Request[v1.InsertCellRequest]) (*connect_go.Response[v1.InsertCellResponse], error) {
+	return c.insertCell.CallUnary(ctx, req)
+}
+
+// UpdateCell calls runme.parser.v1.ParserService.UpdateCell.
+func (c *parserServiceClient) UpdateCell(ctx context.Context, req *connect_go.Request[v1.UpdateCellRequest]) (*connect_go.Response[v1.UpdateCellResponse], error) {
+	return c.updateCell.CallUnary(ctx, req)
+}
+
+// DeleteCell calls runme.parser.v1.ParserService.DeleteCell.
+func (c *parserServiceClient) DeleteCell(ctx context.Context, req *connect_go.Request[v1.DeleteCellRequest]) (*connect_go.Response[v1.DeleteCellResponse], error) {
+	return c.deleteCell.CallUnary(ctx, req)
+}
+
+// MoveCell calls runme.parser.v1.ParserService.MoveCell.
+func (c *parserServiceClient) MoveCell(ctx context.Context, req *connect_go.Request[v1.MoveCellRequest]) (*connect_go.Response[v1.MoveCellResponse], error) {
+	return c.moveCell.CallUnary(ctx, req)
+}
+
+// SplitCell calls runme.parser.v1.ParserService.SplitCell.
+func (c *parserServiceClient) SplitCell(ctx context.Context, req *connect_go.Request[v1.SplitCellRequest]) (*connect_go.Response[v1.SplitCellResponse], error) {
+	return c.splitCell.CallUnary(ctx, req)
+}
+
+// MergeCells calls runme.parser.v1.ParserService.MergeCells.
+func (c *parserServiceClient) MergeCells(ctx context.Context, req *connect_go.Request[v1.MergeCellsRequest]) (*connect_go.Response[v1.MergeCellsResponse], error) {
+	return c.mergeCells.CallUnary(ctx, req)
+}
+
 // ParserServiceHandler is an implementation of the runme.parser.v1.ParserService service.
 type ParserServiceHandler interface {
 	Deserialize(context.Context, *connect_go.Request[v1.DeserializeRequest]) (*connect_go.Response[v1.DeserializeResponse], error)
 	Serialize(context.Context, *connect_go.Request[v1.SerializeRequest]) (*connect_go.Response[v1.SerializeResponse], error)
+	// FromJupyter converts a Jupyter notebook into a notebook
+	// which can be serialized with Serialize.
+	FromJupyter(context.Context, *connect_go.Request[v1.FromJupyterRequest]) (*connect_go.Response[v1.FromJupyterResponse], error)
+	// ToJupyter converts a notebook into a Jupyter notebook.
+	ToJupyter(context.Context, *connect_go.Request[v1.ToJupyterRequest]) (*connect_go.Response[v1.ToJupyterResponse], error)
+	// Cell operations edit a single document without serializing
+	// the whole notebook. They return the minimal text edit.
+	InsertCell(context.Context, *connect_go.Request[v1.InsertCellRequest]) (*connect_go.Response[v1.InsertCellResponse], error)
+	UpdateCell(context.Context, *connect_go.Request[v1.UpdateCellRequest]) (*connect_go.Response[v1.UpdateCellResponse], error)
+	DeleteCell(context.Context, *connect_go.Request[v1.DeleteCellRequest]) (*connect_go.Response[v1.DeleteCellResponse], error)
+	MoveCell(context.Context, *connect_go.Request[v1.MoveCellRequest]) (*connect_go.Response[v1.MoveCellResponse], error)
+	SplitCell(context.Context, *connect_go.Request[v1.SplitCellRequest]) (*connect_go.Response[v1.SplitCellResponse], error)
+	MergeCells(context.Context, *connect_go.Request[v1.MergeCellsRequest]) (*connect_go.Response[v1.MergeCellsResponse], error)
 }
 
 // NewParserServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -93,6 +207,46 @@ func NewParserServiceHandler(svc ParserServiceHandler, opts ...connect_go.Handle
 		svc.Serialize,
 		opts...,
 	))
+	mux.Handle("/runme.parser.v1.ParserService/FromJupyter", connect_go.NewUnaryHandler(
+		"/runme.parser.v1.ParserService/FromJupyter",
+		svc.FromJupyter,
+		opts...,
+	))
+	mux.Handle("/runme.parser.v1.ParserService/ToJupyter", connect_go.NewUnaryHandler(
+		"/runme.parser.v1.ParserService/ToJupyter",
+		svc.ToJupyter,
+		opts...,
+	))
+	mux.Handle("/runme.parser.v1.ParserService/InsertCell", connect_go.NewUnaryHandler(
+		"/runme.parser.v1.ParserService/InsertCell",
+		svc.InsertCell,
+		opts...,
+	))
+	mux.Handle("/runme.parser.v1.ParserService/UpdateCell", connect_go.NewUnaryHandler(
+		"/runme.parser.v1.ParserService/UpdateCell",
+		svc.UpdateCell,
+		opts...,
+	))
+	mux.Handle("/runme.parser.v1.ParserService/DeleteCell", connect_go.NewUnaryHandler(
+		"/runme.parser.v1.ParserService/DeleteCell",
+		svc.DeleteCell,
+		opts...,
+	))
+	mux.Handle("/runme.parser.v1.ParserService/MoveCell", connect_go.NewUnaryHandler(
+		"/runme.parser.v1.ParserService/MoveCell",
+		svc.MoveCell,
+		opts...,
+	))
+	mux.Handle("/runme.parser.v1.ParserService/SplitCell", connect_go.NewUnaryHandler(
+		"/runme.parser.v1.ParserService/SplitCell",
+		svc.SplitCell,
+		opts...,
+	))
+	mux.Handle("/runme.parser.v1.ParserService/MergeCells", connect_go.NewUnaryHandler(
+		"/runme.parser.v1.ParserService/MergeCells",
+		svc.MergeCells,
+		opts...,
+	))
 	return "/runme.parser.v1.ParserService/", mux
 }
 
@@ -106,3 +260,35 @@ func (UnimplementedParserServiceHandler) Deserialize(context.Context, *connect_g
 func (UnimplementedParserServiceHandler) Serialize(context.Context, *connect_go.Request[v1.SerializeRequest]) (*connect_go.Response[v1.SerializeResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("runme.parser.v1.ParserService.Serialize is not implemented"))
 }
+
+func (UnimplementedParserServiceHandler) FromJupyter(context.Context, *connect_go.Request[v1.FromJupyterRequest]) (*connect_go.Response[v1.FromJupyterResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("runme.parser.v1.ParserService.FromJupyter is not implemented"))
+}
+
+func (UnimplementedParserServiceHandler) ToJupyter(context.Context, *connect_go.Request[v1.ToJupyterRequest]) (*connect_go.Response[v1.ToJupyterResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("runme.parser.v1.ParserService.ToJupyter is not implemented"))
+}
+
+func (UnimplementedParserServiceHandler) InsertCell(context.Context, *connect_go.Request[v1.InsertCellRequest]) (*connect_go.Response[v1.InsertCellResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("runme.parser.v1.ParserService.InsertCell is not implemented"))
+}
+
+func (UnimplementedParserServiceHandler) UpdateCell(context.Context, *connect_go.Request[v1.UpdateCellRequest]) (*connect_go.Response[v1.UpdateCellResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("runme.parser.v1.ParserService.UpdateCell is not implemented"))
+}
+
+func (UnimplementedParserServiceHandler) DeleteCell(context.Context, *connect_go.Request[v1.DeleteCellRequest]) (*connect_go.Response[v1.DeleteCellResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("runme.parser.v1.ParserService.DeleteCell is not implemented"))
+}
+
+func (UnimplementedParserServiceHandler) MoveCell(context.Context, *connect_go.Request[v1.MoveCellRequest]) (*connect_go.Response[v1.MoveCellResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("runme.parser.v1.ParserService.MoveCell is not implemented"))
+}
+
+func (UnimplementedParserServiceHandler) SplitCell(context.Context, *connect_go.Request[v1.SplitCellRequest]) (*connect_go.Response[v1.SplitCellResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("runme.parser.v1.ParserService.SplitCell is not implemented"))
+}
+
+func (UnimplementedParserServiceHandler) MergeCells(context.Context, *connect_go.Request[v1.MergeCellsRequest]) (*connect_go.Response[v1.MergeCellsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("runme.parser.v1.ParserService.MergeCells is not implemented"))
+}
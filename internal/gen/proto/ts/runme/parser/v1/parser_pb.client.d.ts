@@ -5,6 +5,22 @@
 // @ts-nocheck
 import type { RpcTransport } from "@protobuf-ts/runtime-rpc";
 import type { ServiceInfo } from "@protobuf-ts/runtime-rpc";
+import type { MergeCellsResponse } from "./parser_pb";
+import type { MergeCellsRequest } from "./parser_pb";
+import type { SplitCellResponse } from "./parser_pb";
+import type { SplitCellRequest } from "./parser_pb";
+import type { MoveCellResponse } from "./parser_pb";
+import type { MoveCellRequest } from "./parser_pb";
+import type { DeleteCellResponse } from "./parser_pb";
+import type { DeleteCellRequest } from "./parser_pb";
+import type { UpdateCellResponse } from "./parser_pb";
+import type { UpdateCellRequest } from "./parser_pb";
+import type { InsertCellResponse } from "./parser_pb";
+import type { InsertCellRequest } from "./parser_pb";
+import type { ToJupyterResponse } from "./parser_pb";
+import type { ToJupyterRequest } from "./parser_pb";
+import type { FromJupyterResponse } from "./parser_pb";
+import type { FromJupyterRequest } from "./parser_pb";
 import type { SerializeResponse } from "./parser_pb";
 import type { SerializeRequest } from "./parser_pb";
 import type { DeserializeResponse } from "./parser_pb";
@@ -23,6 +39,46 @@ export interface IParserServiceClient {
      * @generated from protobuf rpc: Serialize(runme.parser.v1.SerializeRequest) returns (runme.parser.v1.SerializeResponse);
      */
     serialize(input: SerializeRequest, options?: RpcOptions): UnaryCall<SerializeRequest, SerializeResponse>;
+    /**
+     * FromJupyter converts a Jupyter notebook into a notebook
+     * which can be serialized with Serialize.
+     *
+     * @generated from protobuf rpc: FromJupyter(runme.parser.v1.FromJupyterRequest) returns (runme.parser.v1.FromJupyterResponse);
+     */
+    fromJupyter(input: FromJupyterRequest, options?: RpcOptions): UnaryCall<FromJupyterRequest, FromJupyterResponse>;
+    /**
+     * ToJupyter converts a notebook into a Jupyter notebook.
+     *
+     * @generated from protobuf rpc: ToJupyter(runme.parser.v1.ToJupyterRequest) returns (runme.parser.v1.ToJupyterResponse);
+     */
+    toJupyter(input: ToJupyterRequest, options?: RpcOptions): UnaryCall<ToJupyterRequest, ToJupyterResponse>;
+    /**
+     * Cell operations edit a single document without serializing
+     * the whole notebook. They return the minimal text edit.
+     *
+     * @generated from protobuf rpc: InsertCell(runme.parser.v1.InsertCellRequest) returns (runme.parser.v1.InsertCellResponse);
+     */
+    insertCell(input: InsertCellRequest, options?: RpcOptions): UnaryCall<InsertCellRequest, InsertCellResponse>;
+    /**
+     * @generated from protobuf rpc: UpdateCell(runme.parser.v1.UpdateCellRequest) returns (runme.parser.v1.UpdateCellResponse);
+     */
+    updateCell(input: UpdateCellRequest, options?: RpcOptions): UnaryCall<UpdateCellRequest, UpdateCellResponse>;
+    /**
+     * @generated from protobuf rpc: DeleteCell(runme.parser.v1.DeleteCellRequest) returns (runme.parser.v1.DeleteCellResponse);
+     */
+    deleteCell(input: DeleteCellRequest, options?: RpcOptions): UnaryCall<DeleteCellRequest, DeleteCellResponse>;
+    /**
+     * @generated from protobuf rpc: MoveCell(runme.parser.v1.MoveCellRequest) returns (runme.parser.v1.MoveCellResponse);
+     */
+    moveCell(input: MoveCellRequest, options?: RpcOptions): UnaryCall<MoveCellRequest, MoveCellResponse>;
+    /**
+     * @generated from protobuf rpc: SplitCell(runme.parser.v1.SplitCellRequest) returns (runme.parser.v1.SplitCellResponse);
+     */
+    splitCell(input: SplitCellRequest, options?: RpcOptions): UnaryCall<SplitCellRequest, SplitCellResponse>;
+    /**
+     * @generated from protobuf rpc: MergeCells(runme.parser.v1.MergeCellsRequest) returns (runme.parser.v1.MergeCellsResponse);
+     */
+    mergeCells(input: MergeCellsRequest, options?: RpcOptions): UnaryCall<MergeCellsRequest, MergeCellsResponse>;
 }
 /**
  * @generated from protobuf service runme.parser.v1.ParserService
@@ -41,4 +97,44 @@ export declare class ParserServiceClient implements IParserServiceClient, Servic
      * @generated from protobuf rpc: Serialize(runme.parser.v1.SerializeRequest) returns (runme.parser.v1.SerializeResponse);
      */
     serialize(input: SerializeRequest, options?: RpcOptions): UnaryCall<SerializeRequest, SerializeResponse>;
+    /**
+     * FromJupyter converts a Jupyter notebook into a notebook
+     * which can be serialized with Serialize.
+     *
+     * @generated from protobuf rpc: FromJupyter(runme.parser.v1.FromJupyterRequest) returns (runme.parser.v1.FromJupyterResponse);
+     */
+    fromJupyter(input: FromJupyterRequest, options?: RpcOptions): UnaryCall<FromJupyterRequest, FromJupyterResponse>;
+    /**
+     * ToJupyter converts a notebook into a Jupyter notebook.
+     *
+     * @generated from protobuf rpc: ToJupyter(runme.parser.v1.ToJupyterRequest) returns (runme.parser.v1.ToJupyterResponse);
+     */
+    toJupyter(input: ToJupyterRequest, options?: RpcOptions): UnaryCall<ToJupyterRequest, ToJupyterResponse>;
+    /**
+     * Cell operations edit a single document without serializing
+     * the whole notebook. They return the minimal text edit.
+     *
+     * @generated from protobuf rpc: InsertCell(runme.parser.v1.InsertCellRequest) returns (runme.parser.v1.InsertCellResponse);
+     */
+    insertCell(input: InsertCellRequest, options?: RpcOptions): UnaryCall<InsertCellRequest, InsertCellResponse>;
+    /**
+     * @generated from protobuf rpc: UpdateCell(runme.parser.v1.UpdateCellRequest) returns (runme.parser.v1.UpdateCellResponse);
+     */
+    updateCell(input: UpdateCellRequest, options?: RpcOptions): UnaryCall<UpdateCellRequest, UpdateCellResponse>;
+    /**
+     * @generated from protobuf rpc: DeleteCell(runme.parser.v1.DeleteCellRequest) returns (runme.parser.v1.DeleteCellResponse);
+     */
+    deleteCell(input: DeleteCellRequest, options?: RpcOptions): UnaryCall<DeleteCellRequest, DeleteCellResponse>;
+    /**
+     * @generated from protobuf rpc: MoveCell(runme.parser.v1.MoveCellRequest) returns (runme.parser.v1.MoveCellResponse);
+     */
+    moveCell(input: MoveCellRequest, options?: RpcOptions): UnaryCall<MoveCellRequest, MoveCellResponse>;
+    /**
+     * @generated from protobuf rpc: SplitCell(runme.parser.v1.SplitCellRequest) returns (runme.parser.v1.SplitCellResponse);
+     */
+    splitCell(input: SplitCellRequest, options?: RpcOptions): UnaryCall<SplitCellRequest, SplitCellResponse>;
+    /**
+     * @generated from protobuf rpc: MergeCells(runme.parser.v1.MergeCellsRequest) returns (runme.parser.v1.MergeCellsResponse);
+     */
+    mergeCells(input: MergeCellsRequest, options?: RpcOptions): UnaryCall<MergeCellsRequest, MergeCellsResponse>;
 }
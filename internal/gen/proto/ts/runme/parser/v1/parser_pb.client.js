@@ -29,4 +29,68 @@ export class ParserServiceClient {
         const method = this.methods[1], opt = this._transport.mergeOptions(options);
         return stackIntercept("unary", this._transport, method, opt, input);
     }
+    /**
+     * FromJupyter converts a Jupyter notebook into a notebook
+     * which can be serialized with Serialize.
+     *
+     * @generated from protobuf rpc: FromJupyter(runme.parser.v1.FromJupyterRequest) returns (runme.parser.v1.FromJupyterResponse);
+     */
+    fromJupyter(input, options) {
+        const method = this.methods[2], opt = this._transport.mergeOptions(options);
+        return stackIntercept("unary", this._transport, method, opt, input);
+    }
+    /**
+     * ToJupyter converts a notebook into a Jupyter notebook.
+     *
+     * @generated from protobuf rpc: ToJupyter(runme.parser.v1.ToJupyterRequest) returns (runme.parser.v1.ToJupyterResponse);
+     */
+    toJupyter(input, options) {
+        const method = this.methods[3], opt = this._transport.mergeOptions(options);
+        return stackIntercept("unary", this._transport, method, opt, input);
+    }
+    /**
+     * Cell operations edit a single document without serializing
+     * the whole notebook. They return the minimal text edit.
+     *
+     * @generated from protobuf rpc: InsertCell(runme.parser.v1.InsertCellRequest) returns (runme.parser.v1.InsertCellResponse);
+     */
+    insertCell(input, options) {
+        const method = this.methods[4], opt = this._transport.mergeOptions(options);
+        return stackIntercept("unary", this._transport, method, opt, input);
+    }
+    /**
+     * @generated from protobuf rpc: UpdateCell(runme.parser.v1.UpdateCellRequest) returns (runme.parser.v1.UpdateCellResponse);
+     */
+    updateCell(input, options) {
+        const method = this.methods[5], opt = this._transport.mergeOptions(options);
+        return stackIntercept("unary", this._transport, method, opt, input);
+    }
+    /**
+     * @generated from protobuf rpc: DeleteCell(runme.parser.v1.DeleteCellRequest) returns (runme.parser.v1.DeleteCellResponse);
+     */
+    deleteCell(input, options) {
+        const method = this.methods[6], opt = this._transport.mergeOptions(options);
+        return stackIntercept("unary", this._transport, method, opt, input);
+    }
+    /**
+     * @generated from protobuf rpc: MoveCell(runme.parser.v1.MoveCellRequest) returns (runme.parser.v1.MoveCellResponse);
+     */
+    moveCell(input, options) {
+        const method = this.methods[7], opt = this._transport.mergeOptions(options);
+        return stackIntercept("unary", this._transport, method, opt, input);
+    }
+    /**
+     * @generated from protobuf rpc: SplitCell(runme.parser.v1.SplitCellRequest) returns (runme.parser.v1.SplitCellResponse);
+     */
+    splitCell(input, options) {
+        const method = this.methods[8], opt = this._transport.mergeOptions(options);
+        return stackIntercept("unary", this._transport, method, opt, input);
+    }
+    /**
+     * @generated from protobuf rpc: MergeCells(runme.parser.v1.MergeCellsRequest) returns (runme.parser.v1.MergeCellsResponse);
+     */
+    mergeCells(input, options) {
+        const method = this.methods[9], opt = this._transport.mergeOptions(options);
+        return stackIntercept("unary", this._transport, method, opt, input);
+    }
 }
@@ -18,6 +18,89 @@ export interface Notebook {
     metadata: {
         [key: string]: string;
     };
+    /**
+     * frontmatter is a typed representation of the document's
+     * front matter. It is read-only and ignored by Serialize.
+     *
+     * @generated from protobuf field: runme.parser.v1.Frontmatter frontmatter = 3;
+     */
+    frontmatter?: Frontmatter;
+}
+/**
+ * @generated from protobuf message runme.parser.v1.Frontmatter
+ */
+export interface Frontmatter {
+    /**
+     * shell is a program used to execute shell cells.
+     *
+     * @generated from protobuf field: string shell = 1;
+     */
+    shell: string;
+    /**
+     * cwd is a working directory. If relative, it is
+     * relative to the document's directory.
+     *
+     * @generated from protobuf field: string cwd = 2;
+     */
+    cwd: string;
+    /**
+     * env is a set of initial environment variables.
+     *
+     * @generated from protobuf field: map<string, string> env = 3;
+     */
+    env: {
+        [key: string]: string;
+    };
+    /**
+     * skip_prompts disables interactive prompts.
+     *
+     * @generated from protobuf field: bool skip_prompts = 4;
+     */
+    skipPrompts: boolean;
+    /**
+     * tools is a list of programs required to run the document.
+     *
+     * @generated from protobuf field: repeated string tools = 5;
+     */
+    tools: string[];
+}
+/**
+ * @generated from protobuf message runme.parser.v1.Position
+ */
+export interface Position {
+    /**
+     * line is a line number starting at 1.
+     *
+     * @generated from protobuf field: uint32 line = 1;
+     */
+    line: number;
+    /**
+     * column is a byte offset in the line starting at 1.
+     *
+     * @generated from protobuf field: uint32 column = 2;
+     */
+    column: number;
+    /**
+     * offset is a byte offset in the source starting at 0.
+     *
+     * @generated from protobuf field: uint32 offset = 3;
+     */
+    offset: number;
+}
+/**
+ * @generated from protobuf message runme.parser.v1.TextRange
+ */
+export interface TextRange {
+    /**
+     * @generated from protobuf field: runme.parser.v1.Position start = 1;
+     */
+    start?: Position;
+    /**
+     * end points right after the last byte of the range.
+     *
+     * @generated from protobuf field: runme.parser.v1.Position end = 2;
+     */
+    end?: Position;
 }
 /**
  * @generated from protobuf message runme.parser.v1.Cell
@@ -41,6 +124,53 @@ export interface Cell {
     metadata: {
         [key: string]: string;
     };
+    /**
+     * text_range is the range of the source the cell was created from.
+     * It is set only by Deserialize and ignored by Serialize.
+     *
+     * @generated from protobuf field: runme.parser.v1.TextRange text_range = 5;
+     */
+    textRange?: TextRange;
+    /**
+     * outputs are results of executing a code cell.
+     *
+     * @generated from protobuf field: repeated runme.parser.v1.CellOutput outputs = 6;
+     */
+    outputs: CellOutput[];
+}
+/**
+ * @generated from protobuf message runme.parser.v1.CellOutput
+ */
+export interface CellOutput {
+    /**
+     * mime is the type of stdout. Empty means "text/plain".
+     *
+     * @generated from protobuf field: string mime = 1;
+     */
+    mime: string;
+    /**
+     * @generated from protobuf field: string stdout = 2;
+     */
+    stdout: string;
+    /**
+     * @generated from protobuf field: string stderr = 3;
+     */
+    stderr: string;
+    /**
+     * @generated from protobuf field: int32 exit_code = 4;
+     */
+    exitCode: number;
+    /**
+     * start_time and end_time are in RFC 3339 format. They are
+     * empty if unknown.
+     *
+     * @generated from protobuf field: string start_time = 5;
+     */
+    startTime: string;
+    /**
+     * @generated from protobuf field: string end_time = 6;
+     */
+    endTime: string;
 }
 /**
  * @generated from protobuf message runme.parser.v1.DeserializeRequest
@@ -50,6 +180,21 @@ export interface DeserializeRequest {
      * @generated from protobuf field: bytes source = 1;
      */
     source: Uint8Array;
+    /**
+     * assign_ids when true assigns the "id" attribute to code cells
+     * which do not have it. It will be persisted by Serialize.
+     *
+     * @generated from protobuf field: bool assign_ids = 2;
+     */
+    assignIds: boolean;
+    /**
+     * include_outputs when true converts output blocks following
+     * code blocks to outputs of code cells. Otherwise, they are
+     * kept as markup cells.
+     *
+     * @generated from protobuf field: bool include_outputs = 3;
+     */
+    includeOutputs: boolean;
 }
 /**
  * @generated from protobuf message runme.parser.v1.DeserializeResponse
@@ -68,6 +213,13 @@ export interface SerializeRequest {
      * @generated from protobuf field: runme.parser.v1.Notebook notebook = 1;
      */
     notebook?: Notebook;
+    /**
+     * include_outputs when true persists outputs of code cells
+     * in the result. Otherwise, they are dropped.
+     *
+     * @generated from protobuf field: bool include_outputs = 2;
+     */
+    includeOutputs: boolean;
 }
 /**
  * @generated from protobuf message runme.parser.v1.SerializeResponse
@@ -78,6 +230,229 @@ export interface SerializeResponse {
      */
     result: Uint8Array;
 }
+/**
+ * @generated from protobuf message runme.parser.v1.FromJupyterRequest
+ */
+export interface FromJupyterRequest {
+    /**
+     * source is a Jupyter notebook in the nbformat 4 format.
+     *
+     * @generated from protobuf field: bytes source = 1;
+     */
+    source: Uint8Array;
+    /**
+     * include_outputs when true converts text outputs
+     * of code cells to their outputs.
+     *
+     * @generated from protobuf field: bool include_outputs = 2;
+     */
+    includeOutputs: boolean;
+}
+/**
+ * @generated from protobuf message runme.parser.v1.FromJupyterResponse
+ */
+export interface FromJupyterResponse {
+    /**
+     * @generated from protobuf field: runme.parser.v1.Notebook notebook = 1;
+     */
+    notebook?: Notebook;
+}
+/**
+ * @generated from protobuf message runme.parser.v1.ToJupyterRequest
+ */
+export interface ToJupyterRequest {
+    /**
+     * @generated from protobuf field: runme.parser.v1.Notebook notebook = 1;
+     */
+    notebook?: Notebook;
+}
+/**
+ * @generated from protobuf message runme.parser.v1.ToJupyterResponse
+ */
+export interface ToJupyterResponse {
+    /**
+     * result is a Jupyter notebook in the nbformat 4 format.
+     *
+     * @generated from protobuf field: bytes result = 1;
+     */
+    result: Uint8Array;
+}
+/**
+ * TextEdit replaces the text in range of a source with new_text.
+ *
+ * @generated from protobuf message runme.parser.v1.TextEdit
+ */
+export interface TextEdit {
+    /**
+     * @generated from protobuf field: runme.parser.v1.TextRange range = 1;
+     */
+    range?: TextRange;
+    /**
+     * @generated from protobuf field: string new_text = 2;
+     */
+    newText: string;
+}
+/**
+ * @generated from protobuf message runme.parser.v1.InsertCellRequest
+ */
+export interface InsertCellRequest {
+    /**
+     * @generated from protobuf field: bytes source = 1;
+     */
+    source: Uint8Array;
+    /**
+     * index of the inserted cell. It can be equal
+     * to the number of cells to append the cell.
+     *
+     * @generated from protobuf field: uint32 index = 2;
+     */
+    index: number;
+    /**
+     * @generated from protobuf field: runme.parser.v1.Cell cell = 3;
+     */
+    cell?: Cell;
+}
+/**
+ * @generated from protobuf message runme.parser.v1.InsertCellResponse
+ */
+export interface InsertCellResponse {
+    /**
+     * @generated from protobuf field: runme.parser.v1.TextEdit edit = 1;
+     */
+    edit?: TextEdit;
+}
+/**
+ * @generated from protobuf message runme.parser.v1.UpdateCellRequest
+ */
+export interface UpdateCellRequest {
+    /**
+     * @generated from protobuf field: bytes source = 1;
+     */
+    source: Uint8Array;
+    /**
+     * @generated from protobuf field: uint32 index = 2;
+     */
+    index: number;
+    /**
+     * cell replaces the cell at index, including its value,
+     * language and attributes stored in metadata.
+     *
+     * @generated from protobuf field: runme.parser.v1.Cell cell = 3;
+     */
+    cell?: Cell;
+}
+/**
+ * @generated from protobuf message runme.parser.v1.UpdateCellResponse
+ */
+export interface UpdateCellResponse {
+    /**
+     * @generated from protobuf field: runme.parser.v1.TextEdit edit = 1;
+     */
+    edit?: TextEdit;
+}
+/**
+ * @generated from protobuf message runme.parser.v1.DeleteCellRequest
+ */
+export interface DeleteCellRequest {
+    /**
+     * @generated from protobuf field: bytes source = 1;
+     */
+    source: Uint8Array;
+    /**
+     * @generated from protobuf field: uint32 index = 2;
+     */
+    index: number;
+}
+/**
+ * @generated from protobuf message runme.parser.v1.DeleteCellResponse
+ */
+export interface DeleteCellResponse {
+    /**
+     * @generated from protobuf field: runme.parser.v1.TextEdit edit = 1;
+     */
+    edit?: TextEdit;
+}
+/**
+ * @generated from protobuf message runme.parser.v1.MoveCellRequest
+ */
+export interface MoveCellRequest {
+    /**
+     * @generated from protobuf field: bytes source = 1;
+     */
+    source: Uint8Array;
+    /**
+     * @generated from protobuf field: uint32 from = 2;
+     */
+    from: number;
+    /**
+     * to is the index of the cell after moving.
+     *
+     * @generated from protobuf field: uint32 to = 3;
+     */
+    to: number;
+}
+/**
+ * @generated from protobuf message runme.parser.v1.MoveCellResponse
+ */
+export interface MoveCellResponse {
+    /**
+     * @generated from protobuf field: runme.parser.v1.TextEdit edit = 1;
+     */
+    edit?: TextEdit;
+}
+/**
+ * @generated from protobuf message runme.parser.v1.SplitCellRequest
+ */
+export interface SplitCellRequest {
+    /**
+     * @generated from protobuf field: bytes source = 1;
+     */
+    source: Uint8Array;
+    /**
+     * @generated from protobuf field: uint32 index = 2;
+     */
+    index: number;
+    /**
+     * offset is a byte offset in the value of the cell
+     * at which the second cell starts.
+     *
+     * @generated from protobuf field: uint32 offset = 3;
+     */
+    offset: number;
+}
+/**
+ * @generated from protobuf message runme.parser.v1.SplitCellResponse
+ */
+export interface SplitCellResponse {
+    /**
+     * @generated from protobuf field: runme.parser.v1.TextEdit edit = 1;
+     */
+    edit?: TextEdit;
+}
+/**
+ * @generated from protobuf message runme.parser.v1.MergeCellsRequest
+ */
+export interface MergeCellsRequest {
+    /**
+     * @generated from protobuf field: bytes source = 1;
+     */
+    source: Uint8Array;
+    /**
+     * index of the cell which the following cell is merged into.
+     *
+     * @generated from protobuf field: uint32 index = 2;
+     */
+    index: number;
+}
+/**
+ * @generated from protobuf message runme.parser.v1.MergeCellsResponse
+ */
+export interface MergeCellsResponse {
+    /**
+     * @generated from protobuf field: runme.parser.v1.TextEdit edit = 1;
+     */
+    edit?: TextEdit;
+}
 /**
  * @generated from protobuf enum runme.parser.v1.CellKind
  */
@@ -102,6 +477,27 @@ declare class Notebook$Type extends MessageType<Notebook> {
  * @generated MessageType for protobuf message runme.parser.v1.Notebook
  */
 export declare const Notebook: Notebook$Type;
+declare class Frontmatter$Type extends MessageType<Frontmatter> {
+    constructor();
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.Frontmatter
+ */
+export declare const Frontmatter: Frontmatter$Type;
+declare class Position$Type extends MessageType<Position> {
+    constructor();
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.Position
+ */
+export declare const Position: Position$Type;
+declare class TextRange$Type extends MessageType<TextRange> {
+    constructor();
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.TextRange
+ */
+export declare const TextRange: TextRange$Type;
 declare class Cell$Type extends MessageType<Cell> {
     constructor();
 }
@@ -109,6 +505,13 @@ declare class Cell$Type extends MessageType<Cell> {
  * @generated MessageType for protobuf message runme.parser.v1.Cell
  */
 export declare const Cell: Cell$Type;
+declare class CellOutput$Type extends MessageType<CellOutput> {
+    constructor();
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.CellOutput
+ */
+export declare const CellOutput: CellOutput$Type;
 declare class DeserializeRequest$Type extends MessageType<DeserializeRequest> {
     constructor();
 }
@@ -137,6 +540,125 @@ declare class SerializeResponse$Type extends MessageType<SerializeResponse> {
  * @generated MessageType for protobuf message runme.parser.v1.SerializeResponse
  */
 export declare const SerializeResponse: SerializeResponse$Type;
+declare class FromJupyterRequest$Type extends MessageType<FromJupyterRequest> {
+    constructor();
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.FromJupyterRequest
+ */
+export declare const FromJupyterRequest: FromJupyterRequest$Type;
+declare class FromJupyterResponse$Type extends MessageType<FromJupyterResponse> {
+    constructor();
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.FromJupyterResponse
+ */
+export declare const FromJupyterResponse: FromJupyterResponse$Type;
+declare class ToJupyterRequest$Type extends MessageType<ToJupyterRequest> {
+    constructor();
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.ToJupyterRequest
+ */
+export declare const ToJupyterRequest: ToJupyterRequest$Type;
+declare class ToJupyterResponse$Type extends MessageType<ToJupyterResponse> {
+    constructor();
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.ToJupyterResponse
+ */
+export declare const ToJupyterResponse: ToJupyterResponse$Type;
+declare class TextEdit$Type extends MessageType<TextEdit> {
+    constructor();
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.TextEdit
+ */
+export declare const TextEdit: TextEdit$Type;
+declare class InsertCellRequest$Type extends MessageType<InsertCellRequest> {
+    constructor();
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.InsertCellRequest
+ */
+export declare const InsertCellRequest: InsertCellRequest$Type;
+declare class InsertCellResponse$Type extends MessageType<InsertCellResponse> {
+    constructor();
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.InsertCellResponse
+ */
+export declare const InsertCellResponse: InsertCellResponse$Type;
+declare class UpdateCellRequest$Type extends MessageType<UpdateCellRequest> {
+    constructor();
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.UpdateCellRequest
+ */
+export declare const UpdateCellRequest: UpdateCellRequest$Type;
+declare class UpdateCellResponse$Type extends MessageType<UpdateCellResponse> {
+    constructor();
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.UpdateCellResponse
+ */
+export declare const UpdateCellResponse: UpdateCellResponse$Type;
+declare class DeleteCellRequest$Type extends MessageType<DeleteCellRequest> {
+    constructor();
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.DeleteCellRequest
+ */
+export declare const DeleteCellRequest: DeleteCellRequest$Type;
+declare class DeleteCellResponse$Type extends MessageType<DeleteCellResponse> {
+    constructor();
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.DeleteCellResponse
+ */
+export declare const DeleteCellResponse: DeleteCellResponse$Type;
+declare class MoveCellRequest$Type extends MessageType<MoveCellRequest> {
+    constructor();
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.MoveCellRequest
+ */
+export declare const MoveCellRequest: MoveCellRequest$Type;
+declare class MoveCellResponse$Type extends MessageType<MoveCellResponse> {
+    constructor();
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.MoveCellResponse
+ */
+export declare const MoveCellResponse: MoveCellResponse$Type;
+declare class SplitCellRequest$Type extends MessageType<SplitCellRequest> {
+    constructor();
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.SplitCellRequest
+ */
+export declare const SplitCellRequest: SplitCellRequest$Type;
+declare class SplitCellResponse$Type extends MessageType<SplitCellResponse> {
+    constructor();
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.SplitCellResponse
+ */
+export declare const SplitCellResponse: SplitCellResponse$Type;
+declare class MergeCellsRequest$Type extends MessageType<MergeCellsRequest> {
+    constructor();
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.MergeCellsRequest
+ */
+export declare const MergeCellsRequest: MergeCellsRequest$Type;
+declare class MergeCellsResponse$Type extends MessageType<MergeCellsResponse> {
+    constructor();
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.MergeCellsResponse
+ */
+export declare const MergeCellsResponse: MergeCellsResponse$Type;
 /**
  * @generated ServiceType for protobuf service runme.parser.v1.ParserService
  */
@@ -33,7 +33,8 @@ class Notebook$Type extends MessageType {
     constructor() {
         super("runme.parser.v1.Notebook", [
             { no: 1, name: "cells", kind: "message", repeat: 1 /*RepeatType.PACKED*/, T: () => Cell },
-            { no: 2, name: "metadata", kind: "map", K: 9 /*ScalarType.STRING*/, V: { kind: "scalar", T: 9 /*ScalarType.STRING*/ } }
+            { no: 2, name: "metadata", kind: "map", K: 9 /*ScalarType.STRING*/, V: { kind: "scalar", T: 9 /*ScalarType.STRING*/ } },
+            { no: 3, name: "frontmatter", kind: "message", T: () => Frontmatter }
         ]);
     }
 }
@@ -42,13 +43,58 @@ class Notebook$Type extends MessageType {
  */
 export const Notebook = new Notebook$Type();
 // @generated message type with reflection information, may provide speed optimized methods
+class Frontmatter$Type extends MessageType {
+    constructor() {
+        super("runme.parser.v1.Frontmatter", [
+            { no: 1, name: "shell", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "cwd", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "env", kind: "map", K: 9 /*ScalarType.STRING*/, V: { kind: "scalar", T: 9 /*ScalarType.STRING*/ } },
+            { no: 4, name: "skip_prompts", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 5, name: "tools", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.Frontmatter
+ */
+export const Frontmatter = new Frontmatter$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class Position$Type extends MessageType {
+    constructor() {
+        super("runme.parser.v1.Position", [
+            { no: 1, name: "line", kind: "scalar", T: 13 /*ScalarType.UINT32*/ },
+            { no: 2, name: "column", kind: "scalar", T: 13 /*ScalarType.UINT32*/ },
+            { no: 3, name: "offset", kind: "scalar", T: 13 /*ScalarType.UINT32*/ }
+        ]);
+    }
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.Position
+ */
+export const Position = new Position$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class TextRange$Type extends MessageType {
+    constructor() {
+        super("runme.parser.v1.TextRange", [
+            { no: 1, name: "start", kind: "message", T: () => Position },
+            { no: 2, name: "end", kind: "message", T: () => Position }
+        ]);
+    }
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.TextRange
+ */
+export const TextRange = new TextRange$Type();
+// @generated message type with reflection information, may provide speed optimized methods
 class Cell$Type extends MessageType {
     constructor() {
         super("runme.parser.v1.Cell", [
             { no: 1, name: "kind", kind: "enum", T: () => ["runme.parser.v1.CellKind", CellKind, "CELL_KIND_"] },
             { no: 2, name: "value", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
             { no: 3, name: "language_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "metadata", kind: "map", K: 9 /*ScalarType.STRING*/, V: { kind: "scalar", T: 9 /*ScalarType.STRING*/ } }
+            { no: 4, name: "metadata", kind: "map", K: 9 /*ScalarType.STRING*/, V: { kind: "scalar", T: 9 /*ScalarType.STRING*/ } },
+            { no: 5, name: "text_range", kind: "message", T: () => TextRange },
+            { no: 6, name: "outputs", kind: "message", repeat: 1 /*RepeatType.PACKED*/, T: () => CellOutput }
         ]);
     }
 }
@@ -57,10 +103,29 @@ class Cell$Type extends MessageType {
  */
 export const Cell = new Cell$Type();
 // @generated message type with reflection information, may provide speed optimized methods
+class CellOutput$Type extends MessageType {
+    constructor() {
+        super("runme.parser.v1.CellOutput", [
+            { no: 1, name: "mime", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "stdout", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "stderr", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "exit_code", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 5, name: "start_time", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 6, name: "end_time", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.CellOutput
+ */
+export const CellOutput = new CellOutput$Type();
+// @generated message type with reflection information, may provide speed optimized methods
 class DeserializeRequest$Type extends MessageType {
     constructor() {
         super("runme.parser.v1.DeserializeRequest", [
-            { no: 1, name: "source", kind: "scalar", T: 12 /*ScalarType.BYTES*/ }
+            { no: 1, name: "source", kind: "scalar", T: 12 /*ScalarType.BYTES*/ },
+            { no: 2, name: "assign_ids", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 3, name: "include_outputs", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
         ]);
     }
 }
@@ -84,7 +149,8 @@ export const DeserializeResponse = new DeserializeResponse$Type();
 class SerializeRequest$Type extends MessageType {
     constructor() {
         super("runme.parser.v1.SerializeRequest", [
-            { no: 1, name: "notebook", kind: "message", T: () => Notebook }
+            { no: 1, name: "notebook", kind: "message", T: () => Notebook },
+            { no: 2, name: "include_outputs", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
         ]);
     }
 }
@@ -104,10 +170,234 @@ class SerializeResponse$Type extends MessageType {
  * @generated MessageType for protobuf message runme.parser.v1.SerializeResponse
  */
 export const SerializeResponse = new SerializeResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class FromJupyterRequest$Type extends MessageType {
+    constructor() {
+        super("runme.parser.v1.FromJupyterRequest", [
+            { no: 1, name: "source", kind: "scalar", T: 12 /*ScalarType.BYTES*/ },
+            { no: 2, name: "include_outputs", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
+        ]);
+    }
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.FromJupyterRequest
+ */
+export const FromJupyterRequest = new FromJupyterRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class FromJupyterResponse$Type extends MessageType {
+    constructor() {
+        super("runme.parser.v1.FromJupyterResponse", [
+            { no: 1, name: "notebook", kind: "message", T: () => Notebook }
+        ]);
+    }
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.FromJupyterResponse
+ */
+export const FromJupyterResponse = new FromJupyterResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ToJupyterRequest$Type extends MessageType {
+    constructor() {
+        super("runme.parser.v1.ToJupyterRequest", [
+            { no: 1, name: "notebook", kind: "message", T: () => Notebook }
+        ]);
+    }
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.ToJupyterRequest
+ */
+export const ToJupyterRequest = new ToJupyterRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ToJupyterResponse$Type extends MessageType {
+    constructor() {
+        super("runme.parser.v1.ToJupyterResponse", [
+            { no: 1, name: "result", kind: "scalar", T: 12 /*ScalarType.BYTES*/ }
+        ]);
+    }
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.ToJupyterResponse
+ */
+export const ToJupyterResponse = new ToJupyterResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class TextEdit$Type extends MessageType {
+    constructor() {
+        super("runme.parser.v1.TextEdit", [
+            { no: 1, name: "range", kind: "message", T: () => TextRange },
+            { no: 2, name: "new_text", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.TextEdit
+ */
+export const TextEdit = new TextEdit$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class InsertCellRequest$Type extends MessageType {
+    constructor() {
+        super("runme.parser.v1.InsertCellRequest", [
+            { no: 1, name: "source", kind: "scalar", T: 12 /*ScalarType.BYTES*/ },
+            { no: 2, name: "index", kind: "scalar", T: 13 /*ScalarType.UINT32*/ },
+            { no: 3, name: "cell", kind: "message", T: () => Cell }
+        ]);
+    }
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.InsertCellRequest
+ */
+export const InsertCellRequest = new InsertCellRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class InsertCellResponse$Type extends MessageType {
+    constructor() {
+        super("runme.parser.v1.InsertCellResponse", [
+            { no: 1, name: "edit", kind: "message", T: () => TextEdit }
+        ]);
+    }
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.InsertCellResponse
+ */
+export const InsertCellResponse = new InsertCellResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class UpdateCellRequest$Type extends MessageType {
+    constructor() {
+        super("runme.parser.v1.UpdateCellRequest", [
+            { no: 1, name: "source", kind: "scalar", T: 12 /*ScalarType.BYTES*/ },
+            { no: 2, name: "index", kind: "scalar", T: 13 /*ScalarType.UINT32*/ },
+            { no: 3, name: "cell", kind: "message", T: () => Cell }
+        ]);
+    }
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.UpdateCellRequest
+ */
+export const UpdateCellRequest = new UpdateCellRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class UpdateCellResponse$Type extends MessageType {
+    constructor() {
+        super("runme.parser.v1.UpdateCellResponse", [
+            { no: 1, name: "edit", kind: "message", T: () => TextEdit }
+        ]);
+    }
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.UpdateCellResponse
+ */
+export const UpdateCellResponse = new UpdateCellResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class DeleteCellRequest$Type extends MessageType {
+    constructor() {
+        super("runme.parser.v1.DeleteCellRequest", [
+            { no: 1, name: "source", kind: "scalar", T: 12 /*ScalarType.BYTES*/ },
+            { no: 2, name: "index", kind: "scalar", T: 13 /*ScalarType.UINT32*/ }
+        ]);
+    }
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.DeleteCellRequest
+ */
+export const DeleteCellRequest = new DeleteCellRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class DeleteCellResponse$Type extends MessageType {
+    constructor() {
+        super("runme.parser.v1.DeleteCellResponse", [
+            { no: 1, name: "edit", kind: "message", T: () => TextEdit }
+        ]);
+    }
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.DeleteCellResponse
+ */
+export const DeleteCellResponse = new DeleteCellResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class MoveCellRequest$Type extends MessageType {
+    constructor() {
+        super("runme.parser.v1.MoveCellRequest", [
+            { no: 1, name: "source", kind: "scalar", T: 12 /*ScalarType.BYTES*/ },
+            { no: 2, name: "from", kind: "scalar", T: 13 /*ScalarType.UINT32*/ },
+            { no: 3, name: "to", kind: "scalar", T: 13 /*ScalarType.UINT32*/ }
+        ]);
+    }
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.MoveCellRequest
+ */
+export const MoveCellRequest = new MoveCellRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class MoveCellResponse$Type extends MessageType {
+    constructor() {
+        super("runme.parser.v1.MoveCellResponse", [
+            { no: 1, name: "edit", kind: "message", T: () => TextEdit }
+        ]);
+    }
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.MoveCellResponse
+ */
+export const MoveCellResponse = new MoveCellResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class SplitCellRequest$Type extends MessageType {
+    constructor() {
+        super("runme.parser.v1.SplitCellRequest", [
+            { no: 1, name: "source", kind: "scalar", T: 12 /*ScalarType.BYTES*/ },
+            { no: 2, name: "index", kind: "scalar", T: 13 /*ScalarType.UINT32*/ },
+            { no: 3, name: "offset", kind: "scalar", T: 13 /*ScalarType.UINT32*/ }
+        ]);
+    }
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.SplitCellRequest
+ */
+export const SplitCellRequest = new SplitCellRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class SplitCellResponse$Type extends MessageType {
+    constructor() {
+        super("runme.parser.v1.SplitCellResponse", [
+            { no: 1, name: "edit", kind: "message", T: () => TextEdit }
+        ]);
+    }
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.SplitCellResponse
+ */
+export const SplitCellResponse = new SplitCellResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class MergeCellsRequest$Type extends MessageType {
+    constructor() {
+        super("runme.parser.v1.MergeCellsRequest", [
+            { no: 1, name: "source", kind: "scalar", T: 12 /*ScalarType.BYTES*/ },
+            { no: 2, name: "index", kind: "scalar", T: 13 /*ScalarType.UINT32*/ }
+        ]);
+    }
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.MergeCellsRequest
+ */
+export const MergeCellsRequest = new MergeCellsRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class MergeCellsResponse$Type extends MessageType {
+    constructor() {
+        super("runme.parser.v1.MergeCellsResponse", [
+            { no: 1, name: "edit", kind: "message", T: () => TextEdit }
+        ]);
+    }
+}
+/**
+ * @generated MessageType for protobuf message runme.parser.v1.MergeCellsResponse
+ */
+export const MergeCellsResponse = new MergeCellsResponse$Type();
 /**
  * @generated ServiceType for protobuf service runme.parser.v1.ParserService
  */
 export const ParserService = new ServiceType("runme.parser.v1.ParserService", [
     { name: "Deserialize", options: {}, I: DeserializeRequest, O: DeserializeResponse },
-    { name: "Serialize", options: {}, I: SerializeRequest, O: SerializeResponse }
+    { name: "Serialize", options: {}, I: SerializeRequest, O: SerializeResponse },
+    { name: "FromJupyter", options: {}, I: FromJupyterRequest, O: FromJupyterResponse },
+    { name: "ToJupyter", options: {}, I: ToJupyterRequest, O: ToJupyterResponse },
+    { name: "InsertCell", options: {}, I: InsertCellRequest, O: InsertCellResponse },
+    { name: "UpdateCell", options: {}, I: UpdateCellRequest, O: UpdateCellResponse },
+    { name: "DeleteCell", options: {}, I: DeleteCellRequest, O: DeleteCellResponse },
+    { name: "MoveCell", options: {}, I: MoveCellRequest, O: MoveCellResponse },
+    { name: "SplitCell", options: {}, I: SplitCellRequest, O: SplitCellResponse },
+    { name: "MergeCells", options: {}, I: MergeCellsRequest, O: MergeCellsResponse }
 ]);
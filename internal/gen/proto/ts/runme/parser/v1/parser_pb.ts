@@ -46,6 +46,14 @@ export class Notebook extends Message<Notebook> {
    */
   metadata: { [key: string]: string } = {};
 
+  /**
+   * frontmatter is a typed representation of the document's
+   * front matter. It is read-only and ignored by Serialize.
+   *
+   * @generated from field: runme.parser.v1.Frontmatter frontmatter = 3;
+   */
+  frontmatter?: Frontmatter;
+
   constructor(data?: PartialMessage<Notebook>) {
     super();
     proto3.util.initPartial(data, this);
@@ -56,6 +64,7 @@ export class Notebook extends Message<Notebook> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "cells", kind: "message", T: Cell, repeated: true },
     { no: 2, name: "metadata", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 3, name: "frontmatter", kind: "message", T: Frontmatter },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Notebook {
@@ -75,6 +84,178 @@ export class Notebook extends Message<Notebook> {
   }
 }
 
+/**
+ * @generated from message runme.parser.v1.Frontmatter
+ */
+export class Frontmatter extends Message<Frontmatter> {
+  /**
+   * shell is a program used to execute shell cells.
+   *
+   * @generated from field: string shell = 1;
+   */
+  shell = "";
+
+  /**
+   * cwd is a working directory. If relative, it is
+   * relative to the document's directory.
+   *
+   * @generated from field: string cwd = 2;
+   */
+  cwd = "";
+
+  /**
+   * env is a set of initial environment variables.
+   *
+   * @generated from field: map<string, string> env = 3;
+   */
+  env: { [key: string]: string } = {};
+
+  /**
+   * skip_prompts disables interactive prompts.
+   *
+   * @generated from field: bool skip_prompts = 4;
+   */
+  skipPrompts = false;
+
+  /**
+   * tools is a list of programs required to run the document.
+   *
+   * @generated from field: repeated string tools = 5;
+   */
+  tools: string[] = [];
+
+  constructor(data?: PartialMessage<Frontmatter>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime = proto3;
+  static readonly typeName = "runme.parser.v1.Frontmatter";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "shell", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "cwd", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "env", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 4, name: "skip_prompts", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "tools", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Frontmatter {
+    return new Frontmatter().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Frontmatter {
+    return new Frontmatter().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Frontmatter {
+    return new Frontmatter().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Frontmatter | PlainMessage<Frontmatter> | undefined, b: Frontmatter | PlainMessage<Frontmatter> | undefined): boolean {
+    return proto3.util.equals(Frontmatter, a, b);
+  }
+}
+
+/**
+ * @generated from message runme.parser.v1.Position
+ */
+export class Position extends Message<Position> {
+  /**
+   * line is a line number starting at 1.
+   *
+   * @generated from field: uint32 line = 1;
+   */
+  line = 0;
+
+  /**
+   * column is a byte offset in the line starting at 1.
+   *
+   * @generated from field: uint32 column = 2;
+   */
+  column = 0;
+
+  /**
+   * offset is a byte offset in the source starting at 0.
+   *
+   * @generated from field: uint32 offset = 3;
+   */
+  offset = 0;
+
+  constructor(data?: PartialMessage<Position>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime = proto3;
+  static readonly typeName = "runme.parser.v1.Position";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "line", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "column", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "offset", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Position {
+    return new Position().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Position {
+    return new Position().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Position {
+    return new Position().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Position | PlainMessage<Position> | undefined, b: Position | PlainMessage<Position> | undefined): boolean {
+    return proto3.util.equals(Position, a, b);
+  }
+}
+
+/**
+ * @generated from message runme.parser.v1.TextRange
+ */
+export class TextRange extends Message<TextRange> {
+  /**
+   * @generated from field: runme.parser.v1.Position start = 1;
+   */
+  start?: Position;
+
+  /**
+   * end points right after the last byte of the range.
+   *
+   * @generated from field: runme.parser.v1.Position end = 2;
+   */
+  end?: Position;
+
+  constructor(data?: PartialMessage<TextRange>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime = proto3;
+  static readonly typeName = "runme.parser.v1.TextRange";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "start", kind: "message", T: Position },
+    { no: 2, name: "end", kind: "message", T: Position },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TextRange {
+    return new TextRange().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TextRange {
+    return new TextRange().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TextRange {
+    return new TextRange().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TextRange | PlainMessage<TextRange> | undefined, b: TextRange | PlainMessage<TextRange> | undefined): boolean {
+    return proto3.util.equals(TextRange, a, b);
+  }
+}
+
 /**
  * @generated from message runme.parser.v1.Cell
  */
@@ -99,6 +280,21 @@ export class Cell extends Message<Cell> {
    */
   metadata: { [key: string]: string } = {};
 
+  /**
+   * text_range is the range of the source the cell was created from.
+   * It is set only by Deserialize and ignored by Serialize.
+   *
+   * @generated from field: runme.parser.v1.TextRange text_range = 5;
+   */
+  textRange?: TextRange;
+
+  /**
+   * outputs are results of executing a code cell.
+   *
+   * @generated from field: repeated runme.parser.v1.CellOutput outputs = 6;
+   */
+  outputs: CellOutput[] = [];
+
   constructor(data?: PartialMessage<Cell>) {
     super();
     proto3.util.initPartial(data, this);
@@ -111,6 +307,8 @@ export class Cell extends Message<Cell> {
     { no: 2, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "language_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "metadata", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 5, name: "text_range", kind: "message", T: TextRange },
+    { no: 6, name: "outputs", kind: "message", T: CellOutput, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Cell {
@@ -130,6 +328,78 @@ export class Cell extends Message<Cell> {
   }
 }
 
+/**
+ * @generated from message runme.parser.v1.CellOutput
+ */
+export class CellOutput extends Message<CellOutput> {
+  /**
+   * mime is the type of stdout. Empty means "text/plain".
+   *
+   * @generated from field: string mime = 1;
+   */
+  mime = "";
+
+  /**
+   * @generated from field: string stdout = 2;
+   */
+  stdout = "";
+
+  /**
+   * @generated from field: string stderr = 3;
+   */
+  stderr = "";
+
+  /**
+   * @generated from field: int32 exit_code = 4;
+   */
+  exitCode = 0;
+
+  /**
+   * start_time and end_time are in RFC 3339 format. They are
+   * empty if unknown.
+   *
+   * @generated from field: string start_time = 5;
+   */
+  startTime = "";
+
+  /**
+   * @generated from field: string end_time = 6;
+   */
+  endTime = "";
+
+  constructor(data?: PartialMessage<CellOutput>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime = proto3;
+  static readonly typeName = "runme.parser.v1.CellOutput";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "mime", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "stdout", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "stderr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "exit_code", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "start_time", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "end_time", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CellOutput {
+    return new CellOutput().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CellOutput {
+    return new CellOutput().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CellOutput {
+    return new CellOutput().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CellOutput | PlainMessage<CellOutput> | undefined, b: CellOutput | PlainMessage<CellOutput> | undefined): boolean {
+    return proto3.util.equals(CellOutput, a, b);
+  }
+}
+
 /**
  * @generated from message runme.parser.v1.DeserializeRequest
  */
@@ -139,6 +409,23 @@ export class DeserializeRequest extends Message<DeserializeRequest> {
    */
   source = new Uint8Array(0);
 
+  /**
+   * assign_ids when true assigns the "id" attribute to code cells
+   * which do not have it. It will be persisted by Serialize.
+   *
+   * @generated from field: bool assign_ids = 2;
+   */
+  assignIds = false;
+
+  /**
+   * include_outputs when true converts output blocks following
+   * code blocks to outputs of code cells. Otherwise, they are
+   * kept as markup cells.
+   *
+   * @generated from field: bool include_outputs = 3;
+   */
+  includeOutputs = false;
+
   constructor(data?: PartialMessage<DeserializeRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -148,6 +435,8 @@ export class DeserializeRequest extends Message<DeserializeRequest> {
   static readonly typeName = "runme.parser.v1.DeserializeRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "source", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "assign_ids", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "include_outputs", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeserializeRequest {
@@ -213,6 +502,14 @@ export class SerializeRequest extends Message<SerializeRequest> {
    */
   notebook?: Notebook;
 
+  /**
+   * include_outputs when true persists outputs of code cells
+   * in the result. Otherwise, they are dropped.
+   *
+   * @generated from field: bool include_outputs = 2;
+   */
+  includeOutputs = false;
+
   constructor(data?: PartialMessage<SerializeRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -222,6 +519,7 @@ export class SerializeRequest extends Message<SerializeRequest> {
   static readonly typeName = "runme.parser.v1.SerializeRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "notebook", kind: "message", T: Notebook },
+    { no: 2, name: "include_outputs", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SerializeRequest {
@@ -277,3 +575,726 @@ export class SerializeResponse extends Message<SerializeResponse> {
     return proto3.util.equals(SerializeResponse, a, b);
   }
 }
+
+/**
+ * @generated from message runme.parser.v1.FromJupyterRequest
+ */
+export class FromJupyterRequest extends Message<FromJupyterRequest> {
+  /**
+   * source is a Jupyter notebook in the nbformat 4 format.
+   *
+   * @generated from field: bytes source = 1;
+   */
+  source = new Uint8Array(0);
+
+  /**
+   * include_outputs when true converts text outputs
+   * of code cells to their outputs.
+   *
+   * @generated from field: bool include_outputs = 2;
+   */
+  includeOutputs = false;
+
+  constructor(data?: PartialMessage<FromJupyterRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime = proto3;
+  static readonly typeName = "runme.parser.v1.FromJupyterRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "include_outputs", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FromJupyterRequest {
+    return new FromJupyterRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FromJupyterRequest {
+    return new FromJupyterRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FromJupyterRequest {
+    return new FromJupyterRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FromJupyterRequest | PlainMessage<FromJupyterRequest> | undefined, b: FromJupyterRequest | PlainMessage<FromJupyterRequest> | undefined): boolean {
+    return proto3.util.equals(FromJupyterRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message runme.parser.v1.FromJupyterResponse
+ */
+export class FromJupyterResponse extends Message<FromJupyterResponse> {
+  /**
+   * @generated from field: runme.parser.v1.Notebook notebook = 1;
+   */
+  notebook?: Notebook;
+
+  constructor(data?: PartialMessage<FromJupyterResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime = proto3;
+  static readonly typeName = "runme.parser.v1.FromJupyterResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "notebook", kind: "message", T: Notebook },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FromJupyterResponse {
+    return new FromJupyterResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FromJupyterResponse {
+    return new FromJupyterResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FromJupyterResponse {
+    return new FromJupyterResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FromJupyterResponse | PlainMessage<FromJupyterResponse> | undefined, b: FromJupyterResponse | PlainMessage<FromJupyterResponse> | undefined): boolean {
+    return proto3.util.equals(FromJupyterResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message runme.parser.v1.ToJupyterRequest
+ */
+export class ToJupyterRequest extends Message<ToJupyterRequest> {
+  /**
+   * @generated from field: runme.parser.v1.Notebook notebook = 1;
+   */
+  notebook?: Notebook;
+
+  constructor(data?: PartialMessage<ToJupyterRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime = proto3;
+  static readonly typeName = "runme.parser.v1.ToJupyterRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "notebook", kind: "message", T: Notebook },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ToJupyterRequest {
+    return new ToJupyterRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ToJupyterRequest {
+    return new ToJupyterRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ToJupyterRequest {
+    return new ToJupyterRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ToJupyterRequest | PlainMessage<ToJupyterRequest> | undefined, b: ToJupyterRequest | PlainMessage<ToJupyterRequest> | undefined): boolean {
+    return proto3.util.equals(ToJupyterRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message runme.parser.v1.ToJupyterResponse
+ */
+export class ToJupyterResponse extends Message<ToJupyterResponse> {
+  /**
+   * result is a Jupyter notebook in the nbformat 4 format.
+   *
+   * @generated from field: bytes result = 1;
+   */
+  result = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<ToJupyterResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime = proto3;
+  static readonly typeName = "runme.parser.v1.ToJupyterResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "result", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ToJupyterResponse {
+    return new ToJupyterResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ToJupyterResponse {
+    return new ToJupyterResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ToJupyterResponse {
+    return new ToJupyterResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ToJupyterResponse | PlainMessage<ToJupyterResponse> | undefined, b: ToJupyterResponse | PlainMessage<ToJupyterResponse> | undefined): boolean {
+    return proto3.util.equals(ToJupyterResponse, a, b);
+  }
+}
+
+/**
+ * TextEdit replaces the text in range of a source with new_text.
+ *
+ * @generated from message runme.parser.v1.TextEdit
+ */
+export class TextEdit extends Message<TextEdit> {
+  /**
+   * @generated from field: runme.parser.v1.TextRange range = 1;
+   */
+  range?: TextRange;
+
+  /**
+   * @generated from field: string new_text = 2;
+   */
+  newText = "";
+
+  constructor(data?: PartialMessage<TextEdit>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime = proto3;
+  static readonly typeName = "runme.parser.v1.TextEdit";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "range", kind: "message", T: TextRange },
+    { no: 2, name: "new_text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TextEdit {
+    return new TextEdit().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TextEdit {
+    return new TextEdit().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TextEdit {
+    return new TextEdit().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TextEdit | PlainMessage<TextEdit> | undefined, b: TextEdit | PlainMessage<TextEdit> | undefined): boolean {
+    return proto3.util.equals(TextEdit, a, b);
+  }
+}
+
+/**
+ * @generated from message runme.parser.v1.InsertCellRequest
+ */
+export class InsertCellRequest extends Message<InsertCellRequest> {
+  /**
+   * @generated from field: bytes source = 1;
+   */
+  source = new Uint8Array(0);
+
+  /**
+   * index of the inserted cell. It can be equal
+   * to the number of cells to append the cell.
+   *
+   * @generated from field: uint32 index = 2;
+   */
+  index = 0;
+
+  /**
+   * @generated from field: runme.parser.v1.Cell cell = 3;
+   */
+  cell?: Cell;
+
+  constructor(data?: PartialMessage<InsertCellRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime = proto3;
+  static readonly typeName = "runme.parser.v1.InsertCellRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "index", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "cell", kind: "message", T: Cell },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InsertCellRequest {
+    return new InsertCellRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): InsertCellRequest {
+    return new InsertCellRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): InsertCellRequest {
+    return new InsertCellRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: InsertCellRequest | PlainMessage<InsertCellRequest> | undefined, b: InsertCellRequest | PlainMessage<InsertCellRequest> | undefined): boolean {
+    return proto3.util.equals(InsertCellRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message runme.parser.v1.InsertCellResponse
+ */
+export class InsertCellResponse extends Message<InsertCellResponse> {
+  /**
+   * @generated from field: runme.parser.v1.TextEdit edit = 1;
+   */
+  edit?: TextEdit;
+
+  constructor(data?: PartialMessage<InsertCellResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime = proto3;
+  static readonly typeName = "runme.parser.v1.InsertCellResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "edit", kind: "message", T: TextEdit },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InsertCellResponse {
+    return new InsertCellResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): InsertCellResponse {
+    return new InsertCellResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): InsertCellResponse {
+    return new InsertCellResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: InsertCellResponse | PlainMessage<InsertCellResponse> | undefined, b: InsertCellResponse | PlainMessage<InsertCellResponse> | undefined): boolean {
+    return proto3.util.equals(InsertCellResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message runme.parser.v1.UpdateCellRequest
+ */
+export class UpdateCellRequest extends Message<UpdateCellRequest> {
+  /**
+   * @generated from field: bytes source = 1;
+   */
+  source = new Uint8Array(0);
+
+  /**
+   * @generated from field: uint32 index = 2;
+   */
+  index = 0;
+
+  /**
+   * cell replaces the cell at index, including its value,
+   * language and attributes stored in metadata.
+   *
+   * @generated from field: runme.parser.v1.Cell cell = 3;
+   */
+  cell?: Cell;
+
+  constructor(data?: PartialMessage<UpdateCellRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime = proto3;
+  static readonly typeName = "runme.parser.v1.UpdateCellRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "index", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "cell", kind: "message", T: Cell },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateCellRequest {
+    return new UpdateCellRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateCellRequest {
+    return new UpdateCellRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateCellRequest {
+    return new UpdateCellRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateCellRequest | PlainMessage<UpdateCellRequest> | undefined, b: UpdateCellRequest | PlainMessage<UpdateCellRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateCellRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message runme.parser.v1.UpdateCellResponse
+ */
+export class UpdateCellResponse extends Message<UpdateCellResponse> {
+  /**
+   * @generated from field: runme.parser.v1.TextEdit edit = 1;
+   */
+  edit?: TextEdit;
+
+  constructor(data?: PartialMessage<UpdateCellResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime = proto3;
+  static readonly typeName = "runme.parser.v1.UpdateCellResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "edit", kind: "message", T: TextEdit },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateCellResponse {
+    return new UpdateCellResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateCellResponse {
+    return new UpdateCellResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateCellResponse {
+    return new UpdateCellResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateCellResponse | PlainMessage<UpdateCellResponse> | undefined, b: UpdateCellResponse | PlainMessage<UpdateCellResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateCellResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message runme.parser.v1.DeleteCellRequest
+ */
+export class DeleteCellRequest extends Message<DeleteCellRequest> {
+  /**
+   * @generated from field: bytes source = 1;
+   */
+  source = new Uint8Array(0);
+
+  /**
+   * @generated from field: uint32 index = 2;
+   */
+  index = 0;
+
+  constructor(data?: PartialMessage<DeleteCellRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime = proto3;
+  static readonly typeName = "runme.parser.v1.DeleteCellRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "index", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteCellRequest {
+    return new DeleteCellRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteCellRequest {
+    return new DeleteCellRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteCellRequest {
+    return new DeleteCellRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteCellRequest | PlainMessage<DeleteCellRequest> | undefined, b: DeleteCellRequest | PlainMessage<DeleteCellRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteCellRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message runme.parser.v1.DeleteCellResponse
+ */
+export class DeleteCellResponse extends Message<DeleteCellResponse> {
+  /**
+   * @generated from field: runme.parser.v1.TextEdit edit = 1;
+   */
+  edit?: TextEdit;
+
+  constructor(data?: PartialMessage<DeleteCellResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime = proto3;
+  static readonly typeName = "runme.parser.v1.DeleteCellResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "edit", kind: "message", T: TextEdit },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteCellResponse {
+    return new DeleteCellResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteCellResponse {
+    return new DeleteCellResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteCellResponse {
+    return new DeleteCellResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteCellResponse | PlainMessage<DeleteCellResponse> | undefined, b: DeleteCellResponse | PlainMessage<DeleteCellResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteCellResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message runme.parser.v1.MoveCellRequest
+ */
+export class MoveCellRequest extends Message<MoveCellRequest> {
+  /**
+   * @generated from field: bytes source = 1;
+   */
+  source = new Uint8Array(0);
+
+  /**
+   * @generated from field: uint32 from = 2;
+   */
+  from = 0;
+
+  /**
+   * to is the index of the cell after moving.
+   *
+   * @generated from field: uint32 to = 3;
+   */
+  to = 0;
+
+  constructor(data?: PartialMessage<MoveCellRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime = proto3;
+  static readonly typeName = "runme.parser.v1.MoveCellRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "from", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "to", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MoveCellRequest {
+    return new MoveCellRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MoveCellRequest {
+    return new MoveCellRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MoveCellRequest {
+    return new MoveCellRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MoveCellRequest | PlainMessage<MoveCellRequest> | undefined, b: MoveCellRequest | PlainMessage<MoveCellRequest> | undefined): boolean {
+    return proto3.util.equals(MoveCellRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message runme.parser.v1.MoveCellResponse
+ */
+export class MoveCellResponse extends Message<MoveCellResponse> {
+  /**
+   * @generated from field: runme.parser.v1.TextEdit edit = 1;
+   */
+  edit?: TextEdit;
+
+  constructor(data?: PartialMessage<MoveCellResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime = proto3;
+  static readonly typeName = "runme.parser.v1.MoveCellResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "edit", kind: "message", T: TextEdit },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MoveCellResponse {
+    return new MoveCellResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MoveCellResponse {
+    return new MoveCellResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MoveCellResponse {
+    return new MoveCellResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MoveCellResponse | PlainMessage<MoveCellResponse> | undefined, b: MoveCellResponse | PlainMessage<MoveCellResponse> | undefined): boolean {
+    return proto3.util.equals(MoveCellResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message runme.parser.v1.SplitCellRequest
+ */
+export class SplitCellRequest extends Message<SplitCellRequest> {
+  /**
+   * @generated from field: bytes source = 1;
+   */
+  source = new Uint8Array(0);
+
+  /**
+   * @generated from field: uint32 index = 2;
+   */
+  index = 0;
+
+  /**
+   * offset is a byte offset in the value of the cell
+   * at which the second cell starts.
+   *
+   * @generated from field: uint32 offset = 3;
+   */
+  offset = 0;
+
+  constructor(data?: PartialMessage<SplitCellRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime = proto3;
+  static readonly typeName = "runme.parser.v1.SplitCellRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "index", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "offset", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SplitCellRequest {
+    return new SplitCellRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SplitCellRequest {
+    return new SplitCellRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SplitCellRequest {
+    return new SplitCellRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SplitCellRequest | PlainMessage<SplitCellRequest> | undefined, b: SplitCellRequest | PlainMessage<SplitCellRequest> | undefined): boolean {
+    return proto3.util.equals(SplitCellRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message runme.parser.v1.SplitCellResponse
+ */
+export class SplitCellResponse extends Message<SplitCellResponse> {
+  /**
+   * @generated from field: runme.parser.v1.TextEdit edit = 1;
+   */
+  edit?: TextEdit;
+
+  constructor(data?: PartialMessage<SplitCellResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime = proto3;
+  static readonly typeName = "runme.parser.v1.SplitCellResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "edit", kind: "message", T: TextEdit },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SplitCellResponse {
+    return new SplitCellResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SplitCellResponse {
+    return new SplitCellResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SplitCellResponse {
+    return new SplitCellResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SplitCellResponse | PlainMessage<SplitCellResponse> | undefined, b: SplitCellResponse | PlainMessage<SplitCellResponse> | undefined): boolean {
+    return proto3.util.equals(SplitCellResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message runme.parser.v1.MergeCellsRequest
+ */
+export class MergeCellsRequest extends Message<MergeCellsRequest> {
+  /**
+   * @generated from field: bytes source = 1;
+   */
+  source = new Uint8Array(0);
+
+  /**
+   * index of the cell which the following cell is merged into.
+   *
+   * @generated from field: uint32 index = 2;
+   */
+  index = 0;
+
+  constructor(data?: PartialMessage<MergeCellsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime = proto3;
+  static readonly typeName = "runme.parser.v1.MergeCellsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "index", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MergeCellsRequest {
+    return new MergeCellsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MergeCellsRequest {
+    return new MergeCellsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MergeCellsRequest {
+    return new MergeCellsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MergeCellsRequest | PlainMessage<MergeCellsRequest> | undefined, b: MergeCellsRequest | PlainMessage<MergeCellsRequest> | undefined): boolean {
+    return proto3.util.equals(MergeCellsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message runme.parser.v1.MergeCellsResponse
+ */
+export class MergeCellsResponse extends Message<MergeCellsResponse> {
+  /**
+   * @generated from field: runme.parser.v1.TextEdit edit = 1;
+   */
+  edit?: TextEdit;
+
+  constructor(data?: PartialMessage<MergeCellsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime = proto3;
+  static readonly typeName = "runme.parser.v1.MergeCellsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "edit", kind: "message", T: TextEdit },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MergeCellsResponse {
+    return new MergeCellsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MergeCellsResponse {
+    return new MergeCellsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MergeCellsResponse {
+    return new MergeCellsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MergeCellsResponse | PlainMessage<MergeCellsResponse> | undefined, b: MergeCellsResponse | PlainMessage<MergeCellsResponse> | undefined): boolean {
+    return proto3.util.equals(MergeCellsResponse, a, b);
+  }
+}
@@ -29,6 +29,20 @@ export interface Session {
     metadata: {
         [key: string]: string;
     };
+    /**
+     * program_name is a default program used by Execute
+     * if the request does not specify one.
+     *
+     * @generated from protobuf field: string program_name = 4;
+     */
+    programName: string;
+    /**
+     * directory is a default directory used by Execute
+     * if the request does not specify one.
+     *
+     * @generated from protobuf field: string directory = 5;
+     */
+    directory: string;
 }
 /**
  * @generated from protobuf message runme.runner.v1.CreateSessionRequest
@@ -49,6 +63,19 @@ export interface CreateSessionRequest {
      * @generated from protobuf field: repeated string envs = 2;
      */
     envs: string[];
+    /**
+     * program_name is a default program to execute in the session.
+     * Typically, it is a shell declared in the document's front matter.
+     *
+     * @generated from protobuf field: string program_name = 3;
+     */
+    programName: string;
+    /**
+     * directory is a default directory to execute programs in.
+     *
+     * @generated from protobuf field: string directory = 4;
+     */
+    directory: string;
 }
 /**
  * @generated from protobuf message runme.runner.v1.CreateSessionResponse
@@ -182,6 +209,15 @@ export interface ExecuteRequest {
      * @generated from protobuf field: string session_id = 20;
      */
     sessionId: string;
+    /**
+     * ignore_directory_change, when true, leaves the default directory
+     * of the session unchanged if the program changes the working directory.
+     * By default, the final working directory is used by subsequent calls
+     * without the directory field.
+     *
+     * @generated from protobuf field: bool ignore_directory_change = 21;
+     */
+    ignoreDirectoryChange: boolean;
 }
 /**
  * @generated from protobuf message runme.runner.v1.ExecuteResponse
@@ -35,7 +35,9 @@ class Session$Type extends MessageType {
         super("runme.runner.v1.Session", [
             { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
             { no: 2, name: "envs", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "metadata", kind: "map", K: 9 /*ScalarType.STRING*/, V: { kind: "scalar", T: 9 /*ScalarType.STRING*/ } }
+            { no: 3, name: "metadata", kind: "map", K: 9 /*ScalarType.STRING*/, V: { kind: "scalar", T: 9 /*ScalarType.STRING*/ } },
+            { no: 4, name: "program_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 5, name: "directory", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
 }
@@ -48,7 +50,9 @@ class CreateSessionRequest$Type extends MessageType {
     constructor() {
         super("runme.runner.v1.CreateSessionRequest", [
             { no: 1, name: "metadata", kind: "map", K: 9 /*ScalarType.STRING*/, V: { kind: "scalar", T: 9 /*ScalarType.STRING*/ } },
-            { no: 2, name: "envs", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
+            { no: 2, name: "envs", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "program_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "directory", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
 }
@@ -149,7 +153,8 @@ class ExecuteRequest$Type extends MessageType {
             { no: 7, name: "tty", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
             { no: 8, name: "input_data", kind: "scalar", T: 12 /*ScalarType.BYTES*/ },
             { no: 9, name: "stop", kind: "enum", T: () => ["runme.runner.v1.ExecuteStop", ExecuteStop, "EXECUTE_STOP_"] },
-            { no: 20, name: "session_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+            { no: 20, name: "session_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 21, name: "ignore_directory_change", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
         ]);
     }
 }
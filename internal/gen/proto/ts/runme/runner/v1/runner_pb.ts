@@ -57,6 +57,22 @@ export class Session extends Message<Session> {
    */
   metadata: { [key: string]: string } = {};
 
+  /**
+   * program_name is a default program used by Execute
+   * if the request does not specify one.
+   *
+   * @generated from field: string program_name = 4;
+   */
+  programName = "";
+
+  /**
+   * directory is a default directory used by Execute
+   * if the request does not specify one.
+   *
+   * @generated from field: string directory = 5;
+   */
+  directory = "";
+
   constructor(data?: PartialMessage<Session>) {
     super();
     proto3.util.initPartial(data, this);
@@ -68,6 +84,8 @@ export class Session extends Message<Session> {
     { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "envs", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 3, name: "metadata", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 4, name: "program_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "directory", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Session {
@@ -106,6 +124,21 @@ export class CreateSessionRequest extends Message<CreateSessionRequest> {
    */
   envs: string[] = [];
 
+  /**
+   * program_name is a default program to execute in the session.
+   * Typically, it is a shell declared in the document's front matter.
+   *
+   * @generated from field: string program_name = 3;
+   */
+  programName = "";
+
+  /**
+   * directory is a default directory to execute programs in.
+   *
+   * @generated from field: string directory = 4;
+   */
+  directory = "";
+
   constructor(data?: PartialMessage<CreateSessionRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -116,6 +149,8 @@ export class CreateSessionRequest extends Message<CreateSessionRequest> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "metadata", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
     { no: 2, name: "envs", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "program_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "directory", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSessionRequest {
@@ -469,6 +504,16 @@ export class ExecuteRequest extends Message<ExecuteRequest> {
    */
   sessionId = "";
 
+  /**
+   * ignore_directory_change, when true, leaves the default directory
+   * of the session unchanged if the program changes the working directory.
+   * By default, the final working directory is used by subsequent calls
+   * without the directory field.
+   *
+   * @generated from field: bool ignore_directory_change = 21;
+   */
+  ignoreDirectoryChange = false;
+
   constructor(data?: PartialMessage<ExecuteRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -487,6 +532,7 @@ export class ExecuteRequest extends Message<ExecuteRequest> {
     { no: 8, name: "input_data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
     { no: 9, name: "stop", kind: "enum", T: proto3.getEnumType(ExecuteStop) },
     { no: 20, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 21, name: "ignore_directory_change", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExecuteRequest {
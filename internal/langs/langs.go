@@ -0,0 +1,79 @@
+package langs
+
+import (
+	"strings"
+	"sync"
+
+	"golang.org/x/exp/slices"
+)
+
+// supported is the registry of languages of code blocks which
+// can be run. It is shared by the runner and the editor, in which
+// code blocks in other languages become markup cells.
+var (
+	supportedMu sync.RWMutex
+	supported   = []string{
+		"bash",
+		"bat", // fallback to sh
+		"console",
+		"sh",
+		"sh-session",
+		"sh-raw",
+		"shell",
+		"shell-session",
+		"zsh",
+		"go",
+		"python",
+		"py",
+		"javascript",
+		"js",
+		"node",
+		"ruby",
+		"rb",
+		"perl",
+		"deno",
+		"typescript",
+		"ts",
+	}
+)
+
+// Register adds languages, like "python" or "node", to the registry.
+// Languages which are already registered are ignored.
+func Register(langs ...string) {
+	supportedMu.Lock()
+	defer supportedMu.Unlock()
+	for _, lang := range langs {
+		lang = strings.TrimSpace(lang)
+		if lang == "" || slices.Contains(supported, lang) {
+			continue
+		}
+		supported = append(supported, lang)
+	}
+}
+
+// Unregister removes languages from the registry.
+func Unregister(langs ...string) {
+	supportedMu.Lock()
+	defer supportedMu.Unlock()
+	result := supported[:0]
+	for _, lang := range supported {
+		if !slices.Contains(langs, lang) {
+			result = append(result, lang)
+		}
+	}
+	supported = result
+}
+
+// Supported returns the registered languages.
+func Supported() []string {
+	supportedMu.RLock()
+	defer supportedMu.RUnlock()
+	return slices.Clone(supported)
+}
+
+// IsSupported reports whether the language is registered.
+func IsSupported(lang string) bool {
+	supportedMu.RLock()
+	defer supportedMu.RUnlock()
+	return slices.Contains(supported, lang)
+}
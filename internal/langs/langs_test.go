@@ -0,0 +1,27 @@
+package langs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegister(t *testing.T) {
+	t.Cleanup(func() { Unregister("node-test") })
+
+	assert.False(t, IsSupported("node-test"))
+
+	Register("node-test", " ", "sh", "node-test")
+	assert.True(t, IsSupported("node-test"))
+
+	supported := Supported()
+	assert.Contains(t, supported, "go")
+	assert.Contains(t, supported, "sh")
+	assert.Equal(t, "node-test", supported[len(supported)-1])
+	assert.NotContains(t, supported, "")
+	assert.NotContains(t, supported, " ")
+
+	Unregister("node-test")
+	assert.False(t, IsSupported("node-test"))
+	assert.True(t, IsSupported("go"))
+}
@@ -0,0 +1,207 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/stateful/runme/internal/document"
+	"github.com/stateful/runme/internal/renderer/cmark"
+	"github.com/stateful/runme/internal/runner"
+	"github.com/yuin/goldmark/ast"
+)
+
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityNote    Severity = "note"
+)
+
+type Rule struct {
+	ID          string   `json:"id"`
+	Severity    Severity `json:"severity"`
+	Description string   `json:"description"`
+}
+
+var (
+	RuleMissingLanguage = Rule{
+		ID:          "missing-language",
+		Severity:    SeverityWarning,
+		Description: "Code blocks should declare a language.",
+	}
+	RuleDuplicateName = Rule{
+		ID:          "duplicate-name",
+		Severity:    SeverityError,
+		Description: "Names of code blocks must be unique.",
+	}
+	RuleSuffixedName = Rule{
+		ID:          "suffixed-name",
+		Severity:    SeverityWarning,
+		Description: "Generated names of code blocks should not need a numeric suffix to be unique.",
+	}
+	RuleUnsupportedLanguage = Rule{
+		ID:          "unsupported-language",
+		Severity:    SeverityNote,
+		Description: "Code blocks in languages which cannot be executed are not runnable.",
+	}
+	RulePromptWithOutput = Rule{
+		ID:          "prompt-with-output",
+		Severity:    SeverityWarning,
+		Description: "Commands with a \"$ \" prompt should not be mixed with their output outside of console code blocks.",
+	}
+	RuleUnterminatedContinuation = Rule{
+		ID:          "unterminated-continuation",
+		Severity:    SeverityError,
+		Description: "The last line of a shell code block must not end with a line continuation.",
+	}
+	RuleInvalidAttributes = Rule{
+		ID:          "invalid-attributes",
+		Severity:    SeverityError,
+		Description: "Attributes of code blocks must be well-formed.",
+	}
+)
+
+// Rules is a list of all rules in the order they are checked.
+var Rules = []Rule{
+	RuleMissingLanguage,
+	RuleDuplicateName,
+	RuleSuffixedName,
+	RuleUnsupportedLanguage,
+	RulePromptWithOutput,
+	RuleUnterminatedContinuation,
+	RuleInvalidAttributes,
+}
+
+type Diagnostic struct {
+	File     string         `json:"file"`
+	Rule     string         `json:"rule"`
+	Severity Severity       `json:"severity"`
+	Message  string         `json:"message"`
+	Range    document.Range `json:"range"`
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s: %s (%s)", d.File, d.Range.Start.Line, d.Range.Start.Column, d.Severity, d.Message, d.Rule)
+}
+
+// Lint reports problems of code blocks in the markdown source.
+// file is used only to fill in Diagnostic.File.
+func Lint(file string, source []byte) ([]Diagnostic, error) {
+	sections, err := document.ParseSections(source)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse sections")
+	}
+
+	doc := document.New(sections.Content, cmark.NewRenderer(sections.Content)).WithBase(sections.ContentStart)
+	node, _, err := doc.Parse()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse source")
+	}
+
+	l := linter{file: file, source: sections.Content}
+	for _, block := range document.CollectCodeBlocks(node) {
+		l.lintBlock(block)
+	}
+	return l.diagnostics, nil
+}
+
+type linter struct {
+	file        string
+	source      []byte
+	diagnostics []Diagnostic
+}
+
+func (l *linter) report(rule Rule, block *document.CodeBlock, format string, args ...any) {
+	l.diagnostics = append(l.diagnostics, Diagnostic{
+		File:     l.file,
+		Rule:     rule.ID,
+		Severity: rule.Severity,
+		Message:  fmt.Sprintf(format, args...),
+		Range:    block.Range(),
+	})
+}
+
+func (l *linter) lintBlock(block *document.CodeBlock) {
+	lang := block.Language()
+
+	switch {
+	case lang == "" && block.LanguageDetected():
+		l.report(RuleMissingLanguage, block, "code block %q has no language; it looks like %q", block.Name(), block.ProbableLanguage())
+	case lang == "":
+		l.report(RuleMissingLanguage, block, "code block %q has no language", block.Name())
+	case lang == document.IncludeLanguage, lang == document.OutputLanguage:
+		return
+	case !runner.IsSupported(lang):
+		l.report(RuleUnsupportedLanguage, block, "code block %q in %q cannot be executed", block.Name(), lang)
+	}
+
+	// Names matter only for code blocks which can be run.
+	runnable := lang == "" || runner.IsSupported(lang)
+
+	if runnable && block.NameSuffixed() {
+		if name := block.Attributes()["name"]; name != "" {
+			l.report(RuleDuplicateName, block, "name %q is already used; the code block is available as %q", name, block.Name())
+		} else {
+			l.report(RuleSuffixedName, block, "generated name %q is not unique; add a name attribute", block.Name())
+		}
+	}
+
+	if node, ok := block.Unwrap().(*ast.FencedCodeBlock); ok && node.Info != nil {
+		if _, err := document.ParseAttributes(node.Info.Text(l.source)); err != nil {
+			l.report(RuleInvalidAttributes, block, "invalid attributes of code block %q: %s", block.Name(), err)
+		}
+	}
+
+	_, errTimeout := block.Timeout()
+	_, errRetries := block.Retries()
+	_, errRetryDelay := block.RetryDelay()
+	for _, err := range []error{errTimeout, errRetries, errRetryDelay} {
+		if err != nil {
+			l.report(RuleInvalidAttributes, block, "invalid attributes of code block %q: %s", block.Name(), err)
+		}
+	}
+
+	// Code blocks without a language are usually shell snippets too.
+	// Console blocks are expected to contain output and the ones
+	// run by interpreters, like Python, are not shell scripts.
+	if lang == "" || runner.IsSupported(lang) && lang != "go" && !runner.IsInterpreted(lang) && !document.IsConsole(lang) {
+		l.lintShell(block)
+	}
+}
+
+func (l *linter) lintShell(block *document.CodeBlock) {
+	var (
+		lines        = strings.Split(string(block.Content()), "\n")
+		prompts      int
+		outputs      int
+		continuation bool
+	)
+
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+		case continuation:
+		case strings.HasPrefix(trimmed, "$ ") || trimmed == "$":
+			prompts++
+		case strings.HasPrefix(trimmed, "#"):
+		default:
+			outputs++
+		}
+
+		if trimmed != "" {
+			continuation = strings.HasSuffix(trimmed, "\\")
+		}
+	}
+
+	if prompts > 0 && outputs > 0 {
+		l.report(RulePromptWithOutput, block, "code block %q mixes commands with a \"$ \" prompt and their output; use the console language for transcripts", block.Name())
+	}
+
+	if continuation {
+		l.report(RuleUnterminatedContinuation, block, "the last line of code block %q ends with a line continuation", block.Name())
+	}
+}
@@ -0,0 +1,117 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLint(t *testing.T) {
+	data := []byte(`---
+shell: bash
+---
+
+# Runbook
+
+` + "```" + `
+echo no-lang
+` + "```" + `
+
+` + "```sh { name=deploy }" + `
+echo deploy
+` + "```" + `
+
+` + "```sh { name=deploy }" + `
+echo deploy again
+` + "```" + `
+
+` + "```sh" + `
+echo hello
+` + "```" + `
+
+` + "```sh" + `
+echo hello
+` + "```" + `
+
+` + "```yaml" + `
+key: value
+` + "```" + `
+
+` + "```sh { name=session }" + `
+$ ls
+README.md
+` + "```" + `
+
+` + "```sh { name=continued }" + `
+docker run \
+  --rm \
+` + "```" + `
+
+` + "```sh { name=broken" + `
+echo broken
+` + "```" + `
+
+` + "```sh { name=wait timeout=soon }" + `
+kubectl wait
+` + "```" + `
+`)
+
+	diagnostics, err := Lint("README.md", data)
+	require.NoError(t, err)
+
+	type result struct {
+		rule string
+		line int
+	}
+	var results []result
+	for _, d := range diagnostics {
+		assert.Equal(t, "README.md", d.File)
+		results = append(results, result{d.Rule, d.Range.Start.Line})
+	}
+
+	assert.Equal(
+		t,
+		[]result{
+			{RuleMissingLanguage.ID, 7},
+			{RuleDuplicateName.ID, 15},
+			{RuleSuffixedName.ID, 23},
+			{RuleUnsupportedLanguage.ID, 27},
+			{RulePromptWithOutput.ID, 31},
+			{RuleUnterminatedContinuation.ID, 36},
+			{RuleInvalidAttributes.ID, 41},
+			{RuleInvalidAttributes.ID, 45},
+		},
+		results,
+	)
+
+	assert.Equal(t, `README.md:15:1: error: name "deploy" is already used; the code block is available as "deploy-2" (duplicate-name)`, diagnostics[1].String())
+	assert.Equal(t, `invalid attributes of code block "wait": invalid timeout "soon": expected a duration like 30s or 5m`, diagnostics[7].Message)
+}
+
+func TestLint_Clean(t *testing.T) {
+	data := []byte("# Runbook\n\n```sh { name=deploy }\n$ echo deploy\n$ echo \\\n    done\n```\n\n```output { exitCode=0 }\ndeploy\ndone\n```\n\n```runme-include\nsetup.md\n```\n\n```console\n$ echo 1\n1\n```\n\n```python\n\"\"\"\n$ python3 hello.py\nhello\n\"\"\"\nprint(\"hello\")\n```\n")
+	diagnostics, err := Lint("README.md", data)
+	require.NoError(t, err)
+	assert.Empty(t, diagnostics)
+}
+
+func TestToSARIF(t *testing.T) {
+	diagnostics, err := Lint("docs/README.md", []byte("```\necho 1\n```\n"))
+	require.NoError(t, err)
+
+	log := ToSARIF(diagnostics, "1.0.0")
+	assert.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, 1)
+
+	run := log.Runs[0]
+	assert.Equal(t, "runme", run.Tool.Driver.Name)
+	assert.Len(t, run.Tool.Driver.Rules, len(Rules))
+	require.Len(t, run.Results, 1)
+
+	res := run.Results[0]
+	assert.Equal(t, RuleMissingLanguage.ID, res.RuleID)
+	assert.Equal(t, SeverityWarning, res.Level)
+	assert.Equal(t, "docs/README.md", res.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	assert.Equal(t, SARIFRegion{StartLine: 1, StartColumn: 1, EndLine: 3, EndColumn: 4}, res.Locations[0].PhysicalLocation.Region)
+}
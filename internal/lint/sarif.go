@@ -0,0 +1,125 @@
+package lint
+
+// SARIF 2.1.0 structures limited to what is needed to report diagnostics.
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+type SARIFLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []SARIFRun `json:"runs"`
+}
+
+type SARIFRun struct {
+	Tool    SARIFTool     `json:"tool"`
+	Results []SARIFResult `json:"results"`
+}
+
+type SARIFTool struct {
+	Driver SARIFDriver `json:"driver"`
+}
+
+type SARIFDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri,omitempty"`
+	Rules          []SARIFRule `json:"rules"`
+}
+
+type SARIFRule struct {
+	ID                   string              `json:"id"`
+	ShortDescription     SARIFMessage        `json:"shortDescription"`
+	DefaultConfiguration SARIFRuleDefaultCfg `json:"defaultConfiguration"`
+}
+
+type SARIFRuleDefaultCfg struct {
+	Level Severity `json:"level"`
+}
+
+type SARIFMessage struct {
+	Text string `json:"text"`
+}
+
+type SARIFResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     Severity        `json:"level"`
+	Message   SARIFMessage    `json:"message"`
+	Locations []SARIFLocation `json:"locations"`
+}
+
+type SARIFLocation struct {
+	PhysicalLocation SARIFPhysicalLocation `json:"physicalLocation"`
+}
+
+type SARIFPhysicalLocation struct {
+	ArtifactLocation SARIFArtifactLocation `json:"artifactLocation"`
+	Region           SARIFRegion           `json:"region"`
+}
+
+type SARIFArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type SARIFRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+	EndLine     int `json:"endLine"`
+	EndColumn   int `json:"endColumn"`
+}
+
+// ToSARIF converts diagnostics into a SARIF log with a single run.
+// Severities map directly to SARIF levels.
+func ToSARIF(diagnostics []Diagnostic, version string) *SARIFLog {
+	rules := make([]SARIFRule, 0, len(Rules))
+	for _, rule := range Rules {
+		rules = append(rules, SARIFRule{
+			ID:                   rule.ID,
+			ShortDescription:     SARIFMessage{Text: rule.Description},
+			DefaultConfiguration: SARIFRuleDefaultCfg{Level: rule.Severity},
+		})
+	}
+
+	results := make([]SARIFResult, 0, len(diagnostics))
+	for _, d := range diagnostics {
+		results = append(results, SARIFResult{
+			RuleID:  d.Rule,
+			Level:   d.Severity,
+			Message: SARIFMessage{Text: d.Message},
+			Locations: []SARIFLocation{
+				{
+					PhysicalLocation: SARIFPhysicalLocation{
+						ArtifactLocation: SARIFArtifactLocation{URI: d.File},
+						Region: SARIFRegion{
+							StartLine:   d.Range.Start.Line,
+							StartColumn: d.Range.Start.Column,
+							EndLine:     d.Range.End.Line,
+							EndColumn:   d.Range.End.Column,
+						},
+					},
+				},
+			},
+		})
+	}
+
+	return &SARIFLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []SARIFRun{
+			{
+				Tool: SARIFTool{
+					Driver: SARIFDriver{
+						Name:           "runme",
+						Version:        version,
+						InformationURI: "https://github.com/stateful/runme",
+						Rules:          rules,
+					},
+				},
+				Results: results,
+			},
+		},
+	}
+}
@@ -43,6 +43,7 @@ func (b *RingBuffer) Reset() {
 	b.mu.Lock()
 	b.r = 0
 	b.w = 0
+	b.isFull = false
 	b.mu.Unlock()
 }
 
@@ -127,45 +128,44 @@ func (b *RingBuffer) write(p []byte) (n int, err error) {
 		p = p[len(p)-b.size:]
 	}
 
-	var avail int
-	if b.w >= b.r {
-		avail = b.size - b.w + b.r
-	} else {
-		avail = b.r - b.w
-	}
-
 	n = len(p)
+	avail := b.size - b.len()
 
-	if len(p) >= avail {
+	c := copy(b.buf[b.w:], p)
+	copy(b.buf, p[c:])
+	b.w = (b.w + n) % b.size
+
+	// When the buffer gets full, the oldest unread data
+	// is overwritten, hence, reading starts after the
+	// last written byte.
+	if n >= avail {
 		b.isFull = true
 		b.r = b.w
-		c := copy(b.buf[b.w:], p)
-		b.w = copy(b.buf[0:], p[c:])
-		return n, nil
 	}
 
+	return n, err
+}
+
+// len returns the number of unread bytes.
+func (b *RingBuffer) len() int {
+	if b.isFull {
+		return b.size
+	}
 	if b.w >= b.r {
-		c1 := b.size - b.w
-		if c1 >= n {
-			copy(b.buf[b.w:], p)
-			b.w += n
-		} else {
-			copy(b.buf[b.w:], p[:c1])
-			c2 := n - c1
-			copy(b.buf[0:], p[c1:])
-			b.w = c2
-		}
-	} else {
-		copy(b.buf[b.w:], p)
-		b.w += n
+		return b.w - b.r
 	}
+	return b.size - b.r + b.w
+}
 
-	if b.w == b.size {
-		b.isFull = true
-		b.w = 0
-	} else {
-		b.isFull = false
-	}
+// Bytes returns a copy of the unread data without consuming it.
+// If more data than the size of the buffer was written,
+// these are the last written bytes.
+func (b *RingBuffer) Bytes() []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
 
-	return n, err
+	result := make([]byte, b.len())
+	c := copy(result, b.buf[b.r:])
+	copy(result[c:], b.buf[:b.w])
+	return result
 }
@@ -95,6 +95,28 @@ func TestRingBuffer(t *testing.T) {
 	})
 }
 
+func TestRingBuffer_Bytes(t *testing.T) {
+	buf := NewRingBuffer(10)
+	assert.Empty(t, buf.Bytes())
+
+	assertWrite(t, buf, []byte("hello"))
+	assert.Equal(t, []byte("hello"), buf.Bytes())
+	// Bytes does not consume data.
+	assertRead(t, buf, []byte("hel"))
+	assert.Equal(t, []byte("lo"), buf.Bytes())
+
+	// The oldest data is overwritten.
+	assertWrite(t, buf, []byte("world"))
+	assertWrite(t, buf, []byte("12345"))
+	assert.Equal(t, []byte("world12345"), buf.Bytes())
+	assertWrite(t, buf, []byte("678"))
+	assert.Equal(t, []byte("ld12345678"), buf.Bytes())
+	assertWrite(t, buf, []byte("abcdefghijkl"))
+	assert.Equal(t, []byte("cdefghijkl"), buf.Bytes())
+	assertRead(t, buf, []byte("cdefghijkl"))
+	assert.Empty(t, buf.Bytes())
+}
+
 func TestRingBuffer_Close(t *testing.T) {
 	buf := NewRingBuffer(512)
 	assert.NoError(t, buf.Close())
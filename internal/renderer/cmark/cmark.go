@@ -6,25 +6,41 @@ import (
 	"strings"
 	"unicode"
 
+	"github.com/mattn/go-runewidth"
 	"github.com/pkg/errors"
 	"github.com/yuin/goldmark/ast"
+	east "github.com/yuin/goldmark/extension/ast"
 )
 
 type NodeSourceProvider func(ast.Node) ([]byte, bool)
 
 func Render(doc ast.Node, source []byte) ([]byte, error) {
-	lineBreak := []byte{'\n'}
+	r := renderer{
+		lineBreak: detectLineBreak(source),
+	}
+	return r.Render(doc, source)
+}
+
+// NewRenderer returns a function like Render for nodes of source.
+// The line break is detected once, rather than on every call, which
+// matters when blocks of a large document are rendered one by one.
+func NewRenderer(source []byte) func(ast.Node, []byte) ([]byte, error) {
+	lineBreak := detectLineBreak(source)
+	return func(doc ast.Node, source []byte) ([]byte, error) {
+		r := renderer{
+			lineBreak: lineBreak,
+		}
+		return r.Render(doc, source)
+	}
+}
 
+func detectLineBreak(source []byte) []byte {
 	crlfCount := bytes.Count(source, []byte{'\r', '\n'})
 	lfCount := bytes.Count(source, []byte{'\n'})
 	if crlfCount == lfCount {
-		lineBreak = []byte{'\r', '\n'}
-	}
-
-	r := renderer{
-		lineBreak: lineBreak,
+		return []byte{'\r', '\n'}
 	}
-	return r.Render(doc, source)
+	return []byte{'\n'}
 }
 
 type renderer struct {
@@ -32,6 +48,7 @@ type renderer struct {
 
 	beginLine       bool
 	buf             bytes.Buffer
+	footnoteRefs    map[int][]byte
 	inTightListItem bool
 	needCR          int
 	prefix          []byte
@@ -289,6 +306,38 @@ func (r *renderer) Render(doc ast.Node, source []byte) ([]byte, error) {
 				r.blankline()
 			}
 
+		case east.KindTable:
+			if entering {
+				r.blankline()
+				if err := r.writeTable(node.(*east.Table), source); err != nil {
+					return ast.WalkStop, err
+				}
+				r.blankline()
+				return ast.WalkSkipChildren, nil
+			}
+
+		case east.KindFootnoteList:
+			if !entering {
+				r.blankline()
+			}
+
+		case east.KindFootnote:
+			prefix := []byte{' ', ' ', ' ', ' '}
+			if entering {
+				n := node.(*east.Footnote)
+				// Footnotes with a single paragraph are written
+				// one after another, like tight list items.
+				r.inTightListItem = node.ChildCount() == 1
+				if err := r.write([]byte("[^" + string(n.Ref) + "]: ")); err != nil {
+					return ast.WalkStop, err
+				}
+				r.prefix = append(r.prefix, prefix...)
+			} else {
+				r.inTightListItem = false
+				r.prefix = r.prefix[0 : len(r.prefix)-len(prefix)]
+				r.cr()
+			}
+
 		// inline
 		case ast.KindAutoLink:
 			n := node.(*ast.AutoLink)
@@ -323,6 +372,33 @@ func (r *renderer) Render(doc ast.Node, source []byte) ([]byte, error) {
 				return ast.WalkStop, err
 			}
 
+		case east.KindStrikethrough:
+			if err := r.write([]byte{'~', '~'}); err != nil {
+				return ast.WalkStop, err
+			}
+
+		case east.KindTaskCheckBox:
+			if entering {
+				mark := []byte("[ ] ")
+				if node.(*east.TaskCheckBox).IsChecked {
+					mark = []byte("[x] ")
+				}
+				if err := r.write(mark); err != nil {
+					return ast.WalkStop, err
+				}
+			}
+
+		case east.KindFootnoteLink:
+			if entering {
+				ref := r.footnoteRef(node.(*east.FootnoteLink))
+				if err := r.write([]byte("[^" + string(ref) + "]")); err != nil {
+					return ast.WalkStop, err
+				}
+			}
+
+		case east.KindFootnoteBacklink:
+			// Backlinks are added for HTML only.
+
 		case ast.KindImage:
 			if entering {
 				if err := r.write([]byte("![")); err != nil {
@@ -413,6 +489,122 @@ func (r *renderer) Render(doc ast.Node, source []byte) ([]byte, error) {
 	return r.buf.Bytes(), errors.WithStack(err)
 }
 
+// writeTable writes the table with columns padded to the same width
+// and the delimiter row reflecting their alignments.
+func (r *renderer) writeTable(table *east.Table, source []byte) error {
+	columns := len(table.Alignments)
+
+	var rows [][]string
+	for row := table.FirstChild(); row != nil; row = row.NextSibling() {
+		cells := make([]string, columns)
+		idx := 0
+		for cell := row.FirstChild(); cell != nil && idx < columns; cell = cell.NextSibling() {
+			cellRenderer := renderer{footnoteRefs: r.footnoteRefs}
+			value, err := cellRenderer.Render(cell, source)
+			if err != nil {
+				return err
+			}
+			cells[idx] = escapePipes(string(bytes.TrimSpace(value)))
+			idx++
+		}
+		rows = append(rows, cells)
+	}
+
+	widths := make([]int, columns)
+	for idx := range widths {
+		widths[idx] = 3
+		for _, cells := range rows {
+			if w := runewidth.StringWidth(cells[idx]); w > widths[idx] {
+				widths[idx] = w
+			}
+		}
+	}
+
+	delimiters := make([]string, columns)
+	for idx, alignment := range table.Alignments {
+		width := widths[idx]
+		switch alignment {
+		case east.AlignLeft:
+			delimiters[idx] = ":" + strings.Repeat("-", width-1)
+		case east.AlignRight:
+			delimiters[idx] = strings.Repeat("-", width-1) + ":"
+		case east.AlignCenter:
+			delimiters[idx] = ":" + strings.Repeat("-", width-2) + ":"
+		default:
+			delimiters[idx] = strings.Repeat("-", width)
+		}
+	}
+
+	writeRow := func(cells []string) error {
+		var b strings.Builder
+		_, _ = b.WriteString("|")
+		for idx, cell := range cells {
+			padding := widths[idx] - runewidth.StringWidth(cell)
+			left := 0
+			switch table.Alignments[idx] {
+			case east.AlignRight:
+				left = padding
+			case east.AlignCenter:
+				left = padding / 2
+			}
+			_, _ = b.WriteString(" " + strings.Repeat(" ", left) + cell + strings.Repeat(" ", padding-left) + " |")
+		}
+		if err := r.write([]byte(b.String())); err != nil {
+			return err
+		}
+		r.cr()
+		return nil
+	}
+
+	for idx, cells := range rows {
+		if err := writeRow(cells); err != nil {
+			return err
+		}
+		if idx == 0 {
+			if err := writeRow(delimiters); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// escapePipes escapes pipes in the content of a table cell.
+// They might be unescaped, for example, in code spans.
+func escapePipes(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '|' && (i == 0 || s[i-1] != '\\') {
+			_ = b.WriteByte('\\')
+		}
+		_ = b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// footnoteRef returns the label of the footnote the link refers to.
+// Links only store the index of the footnote, hence, all footnotes
+// of the document are collected on the first call.
+func (r *renderer) footnoteRef(link *east.FootnoteLink) []byte {
+	if r.footnoteRefs == nil {
+		r.footnoteRefs = make(map[int][]byte)
+
+		var root ast.Node = link
+		for root.Parent() != nil {
+			root = root.Parent()
+		}
+
+		_ = ast.Walk(root, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+			if n, ok := node.(*east.Footnote); ok && entering {
+				r.footnoteRefs[n.Index] = n.Ref
+			}
+			return ast.WalkContinue, nil
+		})
+	}
+	return r.footnoteRefs[link.Index]
+}
+
 func longestBacktickSeq(data []byte) int {
 	longest, current := 0, 0
 	for _, b := range data {
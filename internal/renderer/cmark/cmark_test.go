@@ -1,25 +1,52 @@
 package cmark_test
 
 import (
+	"flag"
 	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/stateful/runme/internal/document"
 	"github.com/stateful/runme/internal/renderer/cmark"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"github.com/yuin/goldmark"
 	"github.com/yuin/goldmark/text"
 )
 
-func testEquality(t *testing.T, data []byte) {
-	parser := goldmark.DefaultParser()
-	ast := parser.Parse(text.NewReader(data))
+var updateGolden = flag.Bool("update", false, "update golden files")
+
+func render(t *testing.T, data []byte) []byte {
+	t.Helper()
+	ast := document.NewParser().Parse(text.NewReader(data))
 	result, err := cmark.Render(ast, data)
 	require.NoError(t, err)
-	assert.Equal(t, string(data), string(result))
+	return result
+}
+
+func testEquality(t *testing.T, data []byte) {
+	assert.Equal(t, string(data), string(render(t, data)))
+}
+
+func TestRender_LineBreakOfReusedBuffer(t *testing.T) {
+	// Line breaks are detected in the current content of the buffer.
+	data := []byte("# Title\r\n\r\nText.\r\n")
+	assert.Equal(t, "# Title\r\n\r\nText.\r\n", string(render(t, data)))
+
+	copy(data, "# Title\n\nText.\n\n\n\n")
+	assert.Equal(t, "# Title\n\nText.\n", string(render(t, data)))
+}
+
+func TestNewRenderer(t *testing.T) {
+	data := []byte("# Title\r\n\r\nText.\r\n")
+	ast := document.NewParser().Parse(text.NewReader(data))
+
+	render := cmark.NewRenderer(data)
+	result, err := render(ast.LastChild(), data)
+	require.NoError(t, err)
+	assert.Equal(t, "Text.\r\n", string(result))
 }
 
 func TestRender_HTMLBlock(t *testing.T) {
@@ -104,3 +131,34 @@ func TestRender_Testdata(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+// TestRender_GFM renders GitHub Flavored Markdown constructs from
+// testdata/gfm/*.md and compares the result with *.golden files.
+// The golden files are expected to be rendered without changes.
+func TestRender_GFM(t *testing.T) {
+	files, err := filepath.Glob("testdata/gfm/*.md")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	for _, file := range files {
+		file := file
+		name := strings.TrimSuffix(filepath.Base(file), ".md")
+
+		t.Run(name, func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			require.NoError(t, err)
+
+			result := render(t, data)
+
+			goldenFile := strings.TrimSuffix(file, ".md") + ".golden"
+			if *updateGolden {
+				require.NoError(t, os.WriteFile(goldenFile, result, 0o644))
+			}
+
+			golden, err := os.ReadFile(goldenFile)
+			require.NoError(t, err)
+			assert.Equal(t, string(golden), string(result))
+			testEquality(t, golden)
+		})
+	}
+}
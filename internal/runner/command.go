@@ -21,6 +21,7 @@ import (
 const (
 	envStartFileName = ".env_start"
 	envEndFileName   = ".env_end"
+	dirEndFileName   = ".dir_end"
 )
 
 type command struct {
@@ -40,6 +41,14 @@ type command struct {
 	tty *os.File
 
 	tmpEnvDir string
+	tmpDir    string
+
+	// background is true for commands started as jobs.
+	// See Session.startJob().
+	background bool
+	// ignoreDirChange, if true, leaves Session.Dir unchanged
+	// when the command changes the working directory.
+	ignoreDirChange bool
 
 	wg  sync.WaitGroup
 	mu  sync.Mutex
@@ -74,6 +83,15 @@ type commandConfig struct {
 	Commands []string
 	Script   string
 
+	// IgnoreDirChange, if true, leaves Session.Dir unchanged when
+	// a shell changes the working directory. By default, the final
+	// working directory becomes a default one of the session.
+	IgnoreDirChange bool
+
+	// TempDir, if set, is removed together with other
+	// temporary files of the command.
+	TempDir string
+
 	Logger *zap.Logger
 }
 
@@ -119,6 +137,9 @@ func newCommand(cfg *commandConfig) (*command, error) {
 		}
 
 		_, _ = script.WriteString("env -0 > " + filepath.Join(envStorePath, envEndFileName) + "\n")
+		if !cfg.IgnoreDirChange {
+			_, _ = script.WriteString("pwd > " + filepath.Join(envStorePath, dirEndFileName) + "\n")
+		}
 
 		extraArgs = []string{"-c", script.String()}
 	}
@@ -137,7 +158,10 @@ func newCommand(cfg *commandConfig) (*command, error) {
 		Stdout:      cfg.Stdout,
 		Stderr:      cfg.Stderr,
 		tmpEnvDir:   envStorePath,
+		tmpDir:      cfg.TempDir,
 		logger:      cfg.Logger,
+
+		ignoreDirChange: cfg.IgnoreDirChange,
 	}
 
 	if cfg.Tty {
@@ -161,6 +185,12 @@ func (c *command) cleanup() {
 			err = multierr.Append(err, e)
 		}
 	}
+	if c.tmpDir != "" {
+		if e := os.RemoveAll(c.tmpDir); e != nil {
+			c.logger.Info("failed to delete tmpDir", zap.Error(e))
+			err = multierr.Append(err, e)
+		}
+	}
 	if c.tty != nil {
 		if e := c.tty.Close(); e != nil {
 			c.logger.Info("failed to close tty", zap.Error(e))
@@ -354,14 +384,46 @@ func (c *command) collectEnvs() {
 	endEnvs, err := c.readEnvFromFile(envEndFileName)
 	c.seterr(err)
 
+	// Changes of the working directory are tracked by collectDir().
 	newOrUpdated, _, deleted := diffEnvStores(
-		newEnvStore(startEnvs...),
-		newEnvStore(endEnvs...),
+		newEnvStore(startEnvs...).Delete(dirEnvs...),
+		newEnvStore(endEnvs...).Delete(dirEnvs...),
 	)
 
 	c.Session.envStore = newEnvStore(c.cmd.Env...).Add(newOrUpdated...).Delete(deleted...)
 }
 
+// collectDir makes the final working directory of the shell
+// a default directory of the session if it was changed.
+func (c *command) collectDir() {
+	if c.tmpEnvDir == "" || c.ignoreDirChange {
+		return
+	}
+
+	// Failures are not errors of the command. For example, the script
+	// might exit early or remove the directory it changed to.
+	data, err := os.ReadFile(filepath.Join(c.tmpEnvDir, dirEndFileName))
+	if err != nil {
+		c.logger.Info("failed to read the final working directory", zap.Error(err))
+		return
+	}
+
+	dir := strings.TrimSpace(string(data))
+	dirInfo, err := os.Stat(dir)
+	if err != nil {
+		c.logger.Info("failed to stat the final working directory", zap.Error(err))
+		return
+	}
+
+	// The shell might report the directory differently,
+	// for example, with symlinks resolved.
+	if startInfo, err := os.Stat(c.Directory); err == nil && os.SameFile(dirInfo, startInfo) {
+		return
+	}
+
+	c.Session.Dir = dir
+}
+
 // ProcessWait waits only for the process to exit.
 // You rather want to use Wait().
 func (c *command) ProcessWait() error {
@@ -376,8 +438,11 @@ func (c *command) Finalize() (err error) {
 
 	// TODO(adamb): when collecting envs is improved,
 	// this condition might be not needed anymore.
-	if c.cmd.ProcessState.Success() {
+	// Jobs run concurrently with other commands of the session,
+	// hence, their changes of the environment are discarded.
+	if c.cmd.ProcessState.Success() && !c.background {
 		c.collectEnvs()
+		c.collectDir()
 	}
 
 	c.cleanup()
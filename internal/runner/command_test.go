@@ -8,6 +8,7 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"syscall"
 	"testing"
 	"time"
@@ -314,6 +315,54 @@ func Test_command(t *testing.T) {
 			sort(cmd.Session.Envs()),
 		)
 	})
+
+	t.Run("DirChange", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "sub"), 0o700))
+
+		session := NewSession(nil, testCreateLogger(t))
+
+		run := func(ignoreDirChange bool, commands ...string) {
+			cmd, err := newCommand(
+				&commandConfig{
+					ProgramName:     "bash",
+					Directory:       dir,
+					Session:         session,
+					Stdin:           bytes.NewBuffer(nil),
+					Stdout:          io.Discard,
+					Stderr:          io.Discard,
+					IsShell:         true,
+					Commands:        commands,
+					IgnoreDirChange: ignoreDirChange,
+					Logger:          testCreateLogger(t),
+				},
+			)
+			require.NoError(t, err)
+			require.NoError(t, cmd.Start(context.Background()))
+			require.NoError(t, cmd.Wait())
+		}
+
+		run(false, "echo unchanged")
+		assert.Equal(t, "", session.Dir)
+
+		run(true, "cd sub")
+		assert.Equal(t, "", session.Dir)
+
+		// Variables of the shell tracking the directory are not collected.
+		_, ok := session.LookupEnv("PWD")
+		assert.False(t, ok)
+		_, ok = session.LookupEnv("OLDPWD")
+		assert.False(t, ok)
+
+		run(false, "cd sub")
+		expected, err := filepath.EvalSymlinks(filepath.Join(dir, "sub"))
+		require.NoError(t, err)
+		actual, err := filepath.EvalSymlinks(session.Dir)
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	})
 }
 
 func Test_command_Stop(t *testing.T) {
@@ -6,6 +6,10 @@ import (
 	"golang.org/x/exp/slices"
 )
 
+// dirEnvs are variables which shells update when the working
+// directory changes. It is kept in Session.Dir instead.
+var dirEnvs = []string{"PWD", "OLDPWD"}
+
 type envStore struct {
 	values map[string]string
 }
@@ -24,6 +28,11 @@ func (s *envStore) Add(envs ...string) *envStore {
 	return s
 }
 
+func (s *envStore) Get(k string) (string, bool) {
+	v, ok := s.values[k]
+	return v, ok
+}
+
 func (s *envStore) Delete(envs ...string) *envStore {
 	temp := newEnvStore(envs...)
 	for k := range temp.values {
@@ -3,7 +3,12 @@ package runner
 import (
 	"context"
 	"io"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/pkg/errors"
+	"github.com/stateful/runme/internal/langs"
 	"go.uber.org/zap"
 )
 
@@ -21,27 +26,163 @@ type ExecutableConfig struct {
 	Stderr  io.Writer
 	Session *Session
 	Logger  *zap.Logger
+
+	// Timeout stops the executable, including processes started by it,
+	// if it runs longer. Zero means no timeout. See TimeoutError.
+	Timeout time.Duration
+	// Retries is how many times the executable is run again if it fails.
+	Retries int
+	// RetryDelay is the delay of the first retry. It doubles
+	// with every subsequent one.
+	RetryDelay time.Duration
+	// Background starts the executable as a job of the session
+	// and returns without waiting for it. Its output is captured
+	// instead of written to Stdout and Stderr. See Session.Jobs().
+	Background bool
+	// IgnoreDirChange, if true, leaves Session.Dir unchanged when
+	// a shell changes the working directory. By default, it becomes
+	// the directory of subsequent executables.
+	IgnoreDirChange bool
 }
 
-var supportedExecutables = []string{
-	"bash",
-	"bat", // fallback to sh
-	"sh",
-	"sh-raw",
-	"shell",
-	"zsh",
-	"go",
+// NewExecutableFunc creates an executable running a code block given
+// its lines, which shells run as commands, and its content.
+type NewExecutableFunc func(cfg *ExecutableConfig, lines []string, content string) Executable
+
+func newShell(cfg *ExecutableConfig, lines []string, _ string) Executable {
+	return &Shell{ExecutableConfig: cfg, Cmds: lines}
 }
 
-func IsSupported(lang string) bool {
-	for _, item := range supportedExecutables {
-		if item == lang {
-			return true
+func newShellRaw(cfg *ExecutableConfig, lines []string, _ string) Executable {
+	return &ShellRaw{Shell: &Shell{ExecutableConfig: cfg, Cmds: lines}}
+}
+
+func newGo(cfg *ExecutableConfig, _ []string, content string) Executable {
+	return &Go{ExecutableConfig: cfg, Source: content}
+}
+
+func newInterpreter(interpreter InterpreterConfig) NewExecutableFunc {
+	return func(cfg *ExecutableConfig, _ []string, content string) Executable {
+		return &Interpreter{ExecutableConfig: cfg, InterpreterConfig: &interpreter, Source: content}
+	}
+}
+
+// executors create executables for languages of code blocks.
+// Every language in the registry of the langs package added
+// by this package has an executor.
+var (
+	executorsMu sync.RWMutex
+	executors   = map[string]NewExecutableFunc{
+		"bash":          newShell,
+		"bat":           newShell,
+		"sh":            newShell,
+		"shell":         newShell,
+		"zsh":           newShell,
+		"console":       newShellRaw,
+		"sh-raw":        newShellRaw,
+		"sh-session":    newShellRaw,
+		"shell-session": newShellRaw,
+		"go":            newGo,
+		"python":        newInterpreter(pythonInterpreter),
+		"py":            newInterpreter(pythonInterpreter),
+		"javascript":    newInterpreter(nodeInterpreter),
+		"js":            newInterpreter(nodeInterpreter),
+		"node":          newInterpreter(nodeInterpreter),
+		"ruby":          newInterpreter(rubyInterpreter),
+		"rb":            newInterpreter(rubyInterpreter),
+		"perl":          newInterpreter(perlInterpreter),
+		"deno":          newInterpreter(denoInterpreter),
+		"typescript":    newInterpreter(denoInterpreter),
+		"ts":            newInterpreter(denoInterpreter),
+	}
+)
+
+// RegisterExecutables adds languages, like "lua" or "php",
+// to the registry of supported executables. Code blocks in these
+// languages are run by an interpreter with the same name as the language,
+// see RegisterInterpreter to use a different one. Languages which
+// already have an executor are ignored.
+func RegisterExecutables(executables ...string) {
+	executorsMu.Lock()
+	defer executorsMu.Unlock()
+
+	for _, lang := range executables {
+		lang = strings.TrimSpace(lang)
+		if lang == "" {
+			continue
+		}
+		if _, ok := executors[lang]; ok {
+			continue
 		}
+		langs.Register(lang)
+		executors[lang] = newInterpreter(InterpreterConfig{Command: lang, Extension: "." + lang})
 	}
-	return false
 }
 
+// RegisterExecutor makes code blocks in the language runnable
+// with executables created by fn. It replaces the previous executor
+// of the language, if any.
+func RegisterExecutor(lang string, fn NewExecutableFunc) {
+	executorsMu.Lock()
+	defer executorsMu.Unlock()
+	langs.Register(lang)
+	executors[lang] = fn
+}
+
+// RegisterInterpreter makes code blocks in the language runnable
+// with the interpreter.
+func RegisterInterpreter(lang string, interpreter InterpreterConfig) {
+	RegisterExecutor(lang, newInterpreter(interpreter))
+}
+
+// NewExecutable returns an executable running a code block
+// in the language. See NewExecutableFunc.
+func NewExecutable(lang string, cfg *ExecutableConfig, lines []string, content string) (Executable, error) {
+	executorsMu.RLock()
+	fn, ok := executors[lang]
+	executorsMu.RUnlock()
+
+	if !ok {
+		return nil, errors.Errorf("unknown executable: %q", lang)
+	}
+	return fn(cfg, lines, content), nil
+}
+
+// SupportedExecutables returns languages of code blocks which can be run.
+func SupportedExecutables() []string {
+	return langs.Supported()
+}
+
+func IsSupported(lang string) bool {
+	return langs.IsSupported(lang)
+}
+
+// IsInterpreted reports whether code blocks in the language
+// are run by an Interpreter, that is, they are not shell scripts.
+func IsInterpreted(lang string) bool {
+	_, ok := newProbeExecutable(lang).(*Interpreter)
+	return ok
+}
+
+// IsShell reports whether code blocks in the language are run
+// by a shell, for example, "bash" or "console".
 func IsShell(lang string) bool {
-	return lang == "sh" || lang == "shell" || lang == "sh-raw"
+	switch newProbeExecutable(lang).(type) {
+	case *Shell, *ShellRaw:
+		return true
+	}
+	return false
+}
+
+// newProbeExecutable returns an executable of the language
+// used to learn its type, or nil if the language is unknown.
+func newProbeExecutable(lang string) Executable {
+	executorsMu.RLock()
+	fn, ok := executors[lang]
+	executorsMu.RUnlock()
+
+	if !ok {
+		return nil
+	}
+	return fn(&ExecutableConfig{}, nil, "")
 }
@@ -0,0 +1,62 @@
+package runner
+
+import (
+	"testing"
+
+	"github.com/stateful/runme/internal/langs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// restoreExecutors returns a function which restores
+// the registry changed by a test.
+func restoreExecutors() func() {
+	supported := SupportedExecutables()
+
+	executorsMu.RLock()
+	saved := maps.Clone(executors)
+	executorsMu.RUnlock()
+
+	return func() {
+		var added []string
+		for _, lang := range SupportedExecutables() {
+			if !slices.Contains(supported, lang) {
+				added = append(added, lang)
+			}
+		}
+		langs.Unregister(added...)
+
+		executorsMu.Lock()
+		executors = saved
+		executorsMu.Unlock()
+	}
+}
+
+func TestRegisterExecutables(t *testing.T) {
+	t.Cleanup(restoreExecutors())
+
+	assert.False(t, IsSupported("node-test"))
+
+	RegisterExecutables("node-test", " ", "sh", "node-test")
+	assert.True(t, IsSupported("node-test"))
+
+	executables := SupportedExecutables()
+	assert.Contains(t, executables, "go")
+	assert.Contains(t, executables, "sh")
+	assert.Equal(t, "node-test", executables[len(executables)-1])
+	assert.NotContains(t, executables, " ")
+
+	// Registered languages are run by an interpreter of the same name.
+	executable, err := NewExecutable("node-test", &ExecutableConfig{}, nil, "")
+	require.NoError(t, err)
+	require.IsType(t, &Interpreter{}, executable)
+	assert.Equal(t, "node-test", executable.(*Interpreter).Command)
+	assert.Equal(t, ".node-test", executable.(*Interpreter).Extension)
+
+	// Languages which already have an executor keep it.
+	executable, err = NewExecutable("sh", &ExecutableConfig{}, nil, "")
+	require.NoError(t, err)
+	assert.IsType(t, &Shell{}, executable)
+}
@@ -38,7 +38,13 @@ func (g Go) Run(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrapf(err, "failed to create a temp dir")
 	}
-	defer os.RemoveAll(tmpDir)
+	// A job removes the directory when it exits.
+	var jobTmpDir string
+	if g.Background {
+		jobTmpDir = tmpDir
+	} else {
+		defer os.RemoveAll(tmpDir)
+	}
 
 	mainFile := filepath.Join(tmpDir, "main.go")
 
@@ -47,11 +53,24 @@ func (g Go) Run(ctx context.Context) error {
 		return errors.Wrapf(err, "failed to write source to file")
 	}
 
-	c := exec.CommandContext(ctx, executable, "run", mainFile)
-	c.Dir = g.Dir
-	c.Stderr = g.Stderr
-	c.Stdout = g.Stdout
-	c.Stdin = g.Stdin
-
-	return errors.Wrapf(c.Run(), "failed to run command %q", "go run main.go")
+	return g.retry(ctx, func(ctx context.Context) error {
+		cmd, err := newCommand(
+			&commandConfig{
+				ProgramName: executable,
+				Args:        []string{"run", mainFile},
+				Directory:   g.Dir,
+				Session:     g.Session,
+				Tty:         g.Tty,
+				Stdin:       g.Stdin,
+				Stdout:      g.Stdout,
+				Stderr:      g.Stderr,
+				TempDir:     jobTmpDir,
+				Logger:      g.Logger,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		return g.run(ctx, cmd)
+	})
 }
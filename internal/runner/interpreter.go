@@ -0,0 +1,151 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/shlex"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// InterpreterConfig describes how to run code blocks in a language
+// which is not a shell, for example, Python.
+type InterpreterConfig struct {
+	// Command is the interpreter with its arguments, for example,
+	// "deno run --allow-all". The path of the script is appended to it.
+	Command string `yaml:"command"`
+	// Extension is the extension of the script, for example, ".ts".
+	// Some interpreters infer the language from it.
+	Extension string `yaml:"extension,omitempty"`
+}
+
+// Interpreters of languages supported by default.
+var (
+	pythonInterpreter = InterpreterConfig{Command: "python3", Extension: ".py"}
+	nodeInterpreter   = InterpreterConfig{Command: "node", Extension: ".js"}
+	rubyInterpreter   = InterpreterConfig{Command: "ruby", Extension: ".rb"}
+	perlInterpreter   = InterpreterConfig{Command: "perl", Extension: ".pl"}
+	denoInterpreter   = InterpreterConfig{Command: "deno run --allow-all", Extension: ".ts"}
+)
+
+// ParseInterpreters parses a YAML document mapping languages
+// to interpreters, for example:
+//
+//	lua:
+//	  command: lua
+//	  extension: .lua
+func ParseInterpreters(data []byte) (map[string]InterpreterConfig, error) {
+	var result map[string]InterpreterConfig
+	if err := yaml.Unmarshal(data, &result); err != nil {
+		return nil, errors.Wrap(err, "failed to parse interpreters")
+	}
+	for lang, cfg := range result {
+		if strings.TrimSpace(cfg.Command) == "" {
+			return nil, errors.Errorf("missing command of interpreter for %q", lang)
+		}
+	}
+	return result, nil
+}
+
+// Interpreter runs a code block by writing its source to a temporary
+// file and passing its path to an interpreter. A shebang in the first
+// line of the source takes precedence over the configured command.
+// Like shells, interpreters get the environment of the session
+// and, if Tty is set, a pseudo-terminal.
+type Interpreter struct {
+	*ExecutableConfig
+	*InterpreterConfig
+	Source string
+}
+
+var _ Executable = (*Interpreter)(nil)
+
+// command returns the interpreter and its arguments.
+func (i Interpreter) command() ([]string, error) {
+	command := i.Command
+	if line, _, _ := strings.Cut(i.Source, "\n"); strings.HasPrefix(line, "#!") {
+		command = strings.TrimSuffix(line[2:], "\r")
+	}
+	args, err := shlex.Split(command)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid interpreter command %q", command)
+	}
+	if len(args) == 0 {
+		return nil, errors.Errorf("empty interpreter command")
+	}
+	return args, nil
+}
+
+func (i Interpreter) DryRun(ctx context.Context, w io.Writer) {
+	var b bytes.Buffer
+
+	args, err := i.command()
+	if err != nil {
+		_, _ = fmt.Fprintf(w, "%s\n", err)
+		return
+	}
+
+	_, _ = b.WriteString(fmt.Sprintf("// %s script%s in $TEMP\n", strings.Join(args, " "), i.Extension))
+	_, _ = b.WriteString(fmt.Sprintf("// run in %q\n\n", i.Dir))
+	_, _ = b.WriteString(i.Source)
+	_, _ = b.WriteRune('\n')
+
+	_, err = w.Write(b.Bytes())
+	if err != nil {
+		log.Fatalf("failed to write: %s", err)
+	}
+}
+
+func (i Interpreter) Run(ctx context.Context) error {
+	args, err := i.command()
+	if err != nil {
+		return err
+	}
+
+	tmpDir, err := os.MkdirTemp("", "runme-*")
+	if err != nil {
+		return errors.Wrapf(err, "failed to create a temp dir")
+	}
+	// A job removes the directory when it exits.
+	var jobTmpDir string
+	if i.Background {
+		jobTmpDir = tmpDir
+	} else {
+		defer os.RemoveAll(tmpDir)
+	}
+
+	script := filepath.Join(tmpDir, "script"+i.Extension)
+
+	err = os.WriteFile(script, []byte(i.Source), 0o600)
+	if err != nil {
+		return errors.Wrapf(err, "failed to write source to file")
+	}
+
+	return i.retry(ctx, func(ctx context.Context) error {
+		cmd, err := newCommand(
+			&commandConfig{
+				ProgramName: args[0],
+				Args:        append(args[1:len(args):len(args)], script),
+				Directory:   i.Dir,
+				Session:     i.Session,
+				Tty:         i.Tty,
+				Stdin:       i.Stdin,
+				Stdout:      i.Stdout,
+				Stderr:      i.Stderr,
+				TempDir:     jobTmpDir,
+				Logger:      i.Logger,
+			},
+		)
+		if err != nil {
+			return errors.Wrapf(err, "failed to find interpreter %q", args[0])
+		}
+		return i.run(ctx, cmd)
+	})
+}
@@ -0,0 +1,97 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestInterpreter(t *testing.T) {
+	run := func(t *testing.T, cfg InterpreterConfig, source string) string {
+		t.Helper()
+
+		var stdout bytes.Buffer
+		executable := &Interpreter{
+			ExecutableConfig: &ExecutableConfig{
+				Dir:     t.TempDir(),
+				Stdout:  &stdout,
+				Session: NewSession([]string{"GREETING=hello"}, zap.NewNop()),
+				Logger:  zap.NewNop(),
+			},
+			InterpreterConfig: &cfg,
+			Source:            source,
+		}
+		require.NoError(t, executable.Run(context.Background()))
+		return stdout.String()
+	}
+
+	t.Run("Command", func(t *testing.T) {
+		output := run(t, InterpreterConfig{Command: "sh -e", Extension: ".sh"}, "echo \"$GREETING from $(basename \"$0\")\"")
+		assert.Equal(t, "hello from script.sh\n", output)
+	})
+
+	t.Run("Shebang", func(t *testing.T) {
+		output := run(t, InterpreterConfig{Command: "missing-interpreter"}, "#!/usr/bin/env sh\necho $GREETING")
+		assert.Equal(t, "hello\n", output)
+	})
+
+	t.Run("MissingInterpreter", func(t *testing.T) {
+		executable := &Interpreter{
+			ExecutableConfig:  &ExecutableConfig{Logger: zap.NewNop()},
+			InterpreterConfig: &InterpreterConfig{Command: "missing-interpreter"},
+			Source:            "print(1)",
+		}
+		err := executable.Run(context.Background())
+		assert.ErrorContains(t, err, `failed to find interpreter "missing-interpreter"`)
+	})
+}
+
+func TestParseInterpreters(t *testing.T) {
+	interpreters, err := ParseInterpreters([]byte("lua:\n  command: lua\n  extension: .lua\nawk:\n  command: awk -f\n"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]InterpreterConfig{
+		"lua": {Command: "lua", Extension: ".lua"},
+		"awk": {Command: "awk -f"},
+	}, interpreters)
+
+	_, err = ParseInterpreters([]byte("lua:\n  extension: .lua\n"))
+	assert.EqualError(t, err, `missing command of interpreter for "lua"`)
+}
+
+func TestIsShell(t *testing.T) {
+	for _, lang := range []string{"bash", "zsh", "sh", "shell", "console", "sh-raw", "shell-session"} {
+		assert.True(t, IsShell(lang), lang)
+	}
+	for _, lang := range []string{"go", "python", "unknown"} {
+		assert.False(t, IsShell(lang), lang)
+	}
+}
+
+func TestNewExecutable(t *testing.T) {
+	cfg := &ExecutableConfig{}
+
+	executable, err := NewExecutable("py", cfg, nil, "print(1)")
+	require.NoError(t, err)
+	assert.Equal(t, &Interpreter{ExecutableConfig: cfg, InterpreterConfig: &pythonInterpreter, Source: "print(1)"}, executable)
+
+	executable, err = NewExecutable("console", cfg, []string{"echo 1"}, "$ echo 1")
+	require.NoError(t, err)
+	assert.IsType(t, &ShellRaw{}, executable)
+
+	_, err = NewExecutable("lua-test", cfg, nil, "print(1)")
+	assert.EqualError(t, err, `unknown executable: "lua-test"`)
+
+	t.Cleanup(restoreExecutors())
+	RegisterInterpreter("lua-test", InterpreterConfig{Command: "lua"})
+	assert.True(t, IsSupported("lua-test"))
+	assert.True(t, IsInterpreted("lua-test"))
+	assert.False(t, IsInterpreted("bash"))
+	assert.False(t, IsShell("lua-test"))
+	executable, err = NewExecutable("lua-test", cfg, nil, "print(1)")
+	require.NoError(t, err)
+	assert.Equal(t, "lua", executable.(*Interpreter).Command)
+}
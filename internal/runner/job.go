@@ -0,0 +1,225 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stateful/runme/internal/rbuffer"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const (
+	// jobOutputSize is the size of the buffer keeping
+	// the last output of a job.
+	jobOutputSize = 1 << 20 // 1 MiB
+	// jobStopTimeout is how long a job has to exit after
+	// it is asked to stop, before it is killed.
+	jobStopTimeout = 5 * time.Second
+)
+
+// Job is a command running in the background of a session,
+// for example, a development server. Its stdout and stderr
+// are captured to a ring buffer. See ExecutableConfig.Background.
+type Job struct {
+	Name      string
+	PID       int
+	StartTime time.Time
+
+	cmd    *command
+	output *rbuffer.RingBuffer
+	done   chan struct{}
+
+	mu       sync.Mutex
+	err      error
+	stopped  bool
+	exitCode int
+}
+
+// Output returns the last output of the job.
+func (j *Job) Output() []byte {
+	return j.output.Bytes()
+}
+
+// Done returns a channel which is closed when the job exits.
+func (j *Job) Done() <-chan struct{} {
+	return j.done
+}
+
+// Running reports whether the job has not exited yet.
+func (j *Job) Running() bool {
+	select {
+	case <-j.done:
+		return false
+	default:
+		return true
+	}
+}
+
+// Err returns the error with which the job exited.
+func (j *Job) Err() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.err
+}
+
+// Status describes the state of the job, for example,
+// "running" or "exited (1)".
+func (j *Job) Status() string {
+	if j.Running() {
+		return "running"
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.stopped {
+		return "stopped"
+	}
+	return fmt.Sprintf("exited (%d)", j.exitCode)
+}
+
+// Stop asks the job, including processes started by it, to exit
+// and kills it if it does not exit in time. It waits for the job.
+func (j *Job) Stop() error {
+	if !j.Running() {
+		return nil
+	}
+
+	j.mu.Lock()
+	j.stopped = true
+	j.mu.Unlock()
+
+	// The job might have just exited.
+	if err := j.cmd.StopWithSignal(syscall.SIGTERM); err != nil && !errors.Is(err, os.ErrProcessDone) {
+		return err
+	}
+
+	select {
+	case <-j.done:
+		return nil
+	case <-time.After(jobStopTimeout):
+	}
+
+	if err := j.cmd.Kill(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+		return err
+	}
+	<-j.done
+	return nil
+}
+
+func (j *Job) wait() {
+	err := j.cmd.ProcessWait()
+	// Finalize also removes temporary files of the command.
+	if finalizeErr := j.cmd.Finalize(); err == nil {
+		err = finalizeErr
+	}
+
+	j.mu.Lock()
+	j.err = err
+	j.exitCode = exitCodeFromErr(err)
+	j.mu.Unlock()
+
+	close(j.done)
+}
+
+// startJob starts the command in the background as a job named name.
+// A job with the same name can be started again once it exits.
+func (s *Session) startJob(name string, cmd *command) error {
+	if cmd.pty != nil {
+		cmd.cleanup()
+		return errors.New("interactive commands cannot run in the background")
+	}
+
+	s.jobsMu.Lock()
+	defer s.jobsMu.Unlock()
+
+	for idx, job := range s.jobs {
+		if job.Name != name {
+			continue
+		}
+		if job.Running() {
+			cmd.cleanup()
+			return errors.Errorf("job %q is already running", name)
+		}
+		s.jobs = append(s.jobs[:idx], s.jobs[idx+1:]...)
+		break
+	}
+
+	output := rbuffer.NewRingBuffer(jobOutputSize)
+	cmd.Stdin = nil
+	cmd.Stdout = output
+	cmd.Stderr = output
+	cmd.background = true
+
+	// The job outlives the context of the executable.
+	if err := cmd.Start(context.Background()); err != nil {
+		return err
+	}
+
+	job := &Job{
+		Name:      name,
+		PID:       cmd.cmd.Process.Pid,
+		StartTime: time.Now(),
+		cmd:       cmd,
+		output:    output,
+		done:      make(chan struct{}),
+	}
+	go job.wait()
+
+	s.jobs = append(s.jobs, job)
+
+	s.logger.Debug("started job", zap.String("name", name), zap.Int("pid", job.PID))
+
+	return nil
+}
+
+// Jobs returns jobs started in the session, including
+// the exited ones, in the order in which they were started.
+func (s *Session) Jobs() []*Job {
+	s.jobsMu.Lock()
+	defer s.jobsMu.Unlock()
+	return append([]*Job(nil), s.jobs...)
+}
+
+// Job returns the job with the given name.
+func (s *Session) Job(name string) (*Job, bool) {
+	s.jobsMu.Lock()
+	defer s.jobsMu.Unlock()
+	for _, job := range s.jobs {
+		if job.Name == name {
+			return job, true
+		}
+	}
+	return nil, false
+}
+
+// Close ends the session by stopping its running jobs.
+func (s *Session) Close() error {
+	var (
+		wg  sync.WaitGroup
+		mu  sync.Mutex
+		err error
+	)
+
+	for _, job := range s.Jobs() {
+		job := job
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if e := job.Stop(); e != nil {
+				mu.Lock()
+				err = multierr.Append(err, errors.Wrapf(e, "failed to stop job %q", job.Name))
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return err
+}
@@ -0,0 +1,98 @@
+//go:build !windows
+
+package runner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestShell_Background(t *testing.T) {
+	t.Parallel()
+
+	sess := NewSession(nil, zap.NewNop())
+	defer func() { assert.NoError(t, sess.Close()) }()
+
+	newShell := func(name string, cmds ...string) *Shell {
+		return &Shell{
+			ExecutableConfig: &ExecutableConfig{
+				Name:       name,
+				Session:    sess,
+				Logger:     zap.NewNop(),
+				Background: true,
+			},
+			Cmds: cmds,
+		}
+	}
+
+	server := newShell("server", "export SERVER=1", "echo listening", "sleep 30")
+	start := time.Now()
+	require.NoError(t, server.Run(context.Background()))
+	assert.Less(t, time.Since(start), 10*time.Second)
+
+	err := server.Run(context.Background())
+	assert.EqualError(t, err, `failed to start job "server": job "server" is already running`)
+
+	job, ok := sess.Job("server")
+	require.True(t, ok)
+	assert.Equal(t, "running", job.Status())
+	assert.Greater(t, job.PID, 0)
+	assert.Eventually(t, func() bool { return string(job.Output()) == "listening\n" }, 5*time.Second, 10*time.Millisecond)
+
+	failing := newShell("failing", "echo failed >&2", "exit 3")
+	require.NoError(t, failing.Run(context.Background()))
+	failed, ok := sess.Job("failing")
+	require.True(t, ok)
+	<-failed.Done()
+	assert.Equal(t, "exited (3)", failed.Status())
+	assert.Equal(t, "failed\n", string(failed.Output()))
+
+	assert.Len(t, sess.Jobs(), 2)
+
+	require.NoError(t, job.Stop())
+	assert.False(t, job.Running())
+	assert.Equal(t, "stopped", job.Status())
+
+	// Jobs do not change the environment of the session.
+	_, ok = sess.LookupEnv("SERVER")
+	assert.False(t, ok)
+
+	// An exited job can be started again.
+	require.NoError(t, server.Run(context.Background()))
+	assert.Len(t, sess.Jobs(), 2)
+	job, _ = sess.Job("server")
+	assert.True(t, job.Running())
+}
+
+func TestSession_Close(t *testing.T) {
+	t.Parallel()
+
+	sess := NewSession(nil, zap.NewNop())
+
+	for _, name := range []string{"first", "second"} {
+		shell := &Shell{
+			ExecutableConfig: &ExecutableConfig{
+				Name:       name,
+				Session:    sess,
+				Logger:     zap.NewNop(),
+				Background: true,
+			},
+			// The child process must be stopped as well.
+			Cmds: []string{"sleep 30"},
+		}
+		require.NoError(t, shell.Run(context.Background()))
+	}
+
+	start := time.Now()
+	require.NoError(t, sess.Close())
+	assert.Less(t, time.Since(start), 10*time.Second)
+
+	for _, job := range sess.Jobs() {
+		assert.Equal(t, "stopped", job.Status())
+	}
+}
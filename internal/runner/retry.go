@@ -0,0 +1,72 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TimeoutExitCode is the exit code of a command which timed out.
+// It is the same as the one of the timeout utility.
+const TimeoutExitCode = 124
+
+// TimeoutError is returned when an executable runs longer
+// than ExecutableConfig.Timeout.
+type TimeoutError struct {
+	Name    string
+	Timeout time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	if e.Name == "" {
+		return fmt.Sprintf("command timed out after %s", e.Timeout)
+	}
+	return fmt.Sprintf("command %q timed out after %s", e.Name, e.Timeout)
+}
+
+// ExitCode returns TimeoutExitCode.
+func (e *TimeoutError) ExitCode() int {
+	return TimeoutExitCode
+}
+
+// retry calls fn until it succeeds, at most Retries + 1 times. Each call
+// is limited by Timeout. Retries are delayed by RetryDelay, which
+// doubles every time, and stop when the context is done.
+func (c *ExecutableConfig) retry(ctx context.Context, fn func(context.Context) error) error {
+	delay := c.RetryDelay
+
+	for attempt := 1; ; attempt++ {
+		err := c.withTimeout(ctx, fn)
+		if err == nil || attempt > c.Retries || ctx.Err() != nil {
+			return err
+		}
+
+		if c.Stderr != nil {
+			_, _ = fmt.Fprintf(c.Stderr, "runme: %s; retrying in %s (%d of %d)\n", err, delay, attempt, c.Retries)
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+func (c *ExecutableConfig) withTimeout(ctx context.Context, fn func(context.Context) error) error {
+	if c.Timeout <= 0 {
+		return fn(ctx)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	err := fn(timeoutCtx)
+	if errors.Is(timeoutCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+		return &TimeoutError{Name: c.Name, Timeout: c.Timeout}
+	}
+	return err
+}
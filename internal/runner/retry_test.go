@@ -0,0 +1,102 @@
+//go:build !windows
+
+package runner
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestShell_Timeout(t *testing.T) {
+	t.Parallel()
+
+	var stdout bytes.Buffer
+
+	shell := &Shell{
+		ExecutableConfig: &ExecutableConfig{
+			Name:    "wait",
+			Stdout:  &stdout,
+			Session: NewSession(nil, zap.NewNop()),
+			Logger:  zap.NewNop(),
+			Timeout: 500 * time.Millisecond,
+		},
+		// The child process keeps stdout open, hence
+		// it must be killed as well.
+		Cmds: []string{"echo started", "sleep 30"},
+	}
+
+	start := time.Now()
+	err := shell.Run(context.Background())
+	assert.Less(t, time.Since(start), 10*time.Second)
+
+	var timeoutErr *TimeoutError
+	require.True(t, errors.As(err, &timeoutErr))
+	assert.EqualError(t, err, `command "wait" timed out after 500ms`)
+	assert.Equal(t, TimeoutExitCode, timeoutErr.ExitCode())
+	assert.Equal(t, "started\n", stdout.String())
+}
+
+func TestShell_Retries(t *testing.T) {
+	t.Parallel()
+
+	newShell := func(retries int) (*Shell, *bytes.Buffer) {
+		counter := filepath.Join(t.TempDir(), "counter")
+		var stderr bytes.Buffer
+		return &Shell{
+			ExecutableConfig: &ExecutableConfig{
+				Name:       "flaky",
+				Stderr:     &stderr,
+				Session:    NewSession(nil, zap.NewNop()),
+				Logger:     zap.NewNop(),
+				Retries:    retries,
+				RetryDelay: 10 * time.Millisecond,
+			},
+			// Fails until run for the third time.
+			Cmds: []string{"echo x >> " + counter, `test "$(wc -l < ` + counter + `)" -ge 3`},
+		}, &stderr
+	}
+
+	shell, stderr := newShell(1)
+	assert.Error(t, shell.Run(context.Background()))
+	assert.Contains(t, stderr.String(), "retrying in 10ms (1 of 1)")
+
+	shell, stderr = newShell(2)
+	require.NoError(t, shell.Run(context.Background()))
+	assert.Contains(t, stderr.String(), `runme: failed to run command "flaky": exit status 1; retrying in 10ms (1 of 2)`)
+	assert.Contains(t, stderr.String(), "retrying in 20ms (2 of 2)")
+}
+
+func TestExecutableConfig_retryTimeout(t *testing.T) {
+	t.Parallel()
+
+	cfg := &ExecutableConfig{Timeout: 10 * time.Millisecond, Retries: 2}
+
+	attempts := 0
+	err := cfg.retry(context.Background(), func(ctx context.Context) error {
+		attempts++
+		if attempts < 3 {
+			<-ctx.Done()
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	attempts = 0
+	err = cfg.retry(ctx, func(ctx context.Context) error {
+		attempts++
+		return errors.New("failed")
+	})
+	assert.EqualError(t, err, "failed")
+	assert.Equal(t, 1, attempts)
+}
@@ -54,9 +54,11 @@ func newRunnerService(logger *zap.Logger) *runnerService {
 
 func toRunnerv1Session(sess *Session) *runnerv1.Session {
 	return &runnerv1.Session{
-		Id:       sess.ID,
-		Envs:     sess.Envs(),
-		Metadata: sess.Metadata,
+		Id:          sess.ID,
+		Envs:        sess.Envs(),
+		Metadata:    sess.Metadata,
+		ProgramName: sess.ProgramName,
+		Directory:   sess.Dir,
 	}
 }
 
@@ -65,6 +67,8 @@ func (r *runnerService) CreateSession(ctx context.Context, req *runnerv1.CreateS
 
 	r.mu.Lock()
 	sess := NewSession(req.Envs, r.logger)
+	sess.ProgramName = req.ProgramName
+	sess.Dir = req.Directory
 	r.sessions = append(r.sessions, sess)
 	r.mu.Unlock()
 
@@ -177,10 +181,20 @@ func (r *runnerService) Execute(srv runnerv1.RunnerService_ExecuteServer) error
 	defer func() { _ = stdout.Close() }()
 	defer func() { _ = stderr.Close() }()
 
+	programName := req.ProgramName
+	if programName == "" {
+		programName = sess.ProgramName
+	}
+
+	directory := req.Directory
+	if directory == "" {
+		directory = sess.Dir
+	}
+
 	cfg := &commandConfig{
-		ProgramName: req.ProgramName,
+		ProgramName: programName,
 		Args:        req.Arguments,
-		Directory:   req.Directory,
+		Directory:   directory,
 		Session:     sess,
 		Tty:         req.Tty,
 		Stdin:       stdin,
@@ -190,6 +204,8 @@ func (r *runnerService) Execute(srv runnerv1.RunnerService_ExecuteServer) error
 		Commands:    req.Commands,
 		Script:      req.Script,
 		Logger:      r.logger,
+
+		IgnoreDirChange: req.IgnoreDirectoryChange,
 	}
 	logger.Debug("command config", zap.Any("cfg", cfg))
 	cmd, err := newCommand(cfg)
@@ -9,6 +9,7 @@ import (
 	"net"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"runtime"
 	"testing"
 	"time"
@@ -565,6 +566,36 @@ func Test_runnerService(t *testing.T) {
 		assert.EqualValues(t, 130, result.ExitCode)
 	})
 
+	t.Run("ExecuteSessionDefaults", func(t *testing.T) {
+		t.Parallel()
+
+		session, err := client.CreateSession(context.Background(), &runnerv1.CreateSessionRequest{
+			ProgramName: "bash",
+			Directory:   "../..",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "bash", session.Session.ProgramName)
+		assert.Equal(t, "../..", session.Session.Directory)
+
+		stream, err := client.Execute(context.Background())
+		require.NoError(t, err)
+
+		execResult := make(chan executeResult)
+		go getExecuteResult(stream, execResult)
+
+		err = stream.Send(&runnerv1.ExecuteRequest{
+			Commands:  []string{"head -n 1 LICENSE"},
+			SessionId: session.Session.Id,
+		})
+		assert.NoError(t, err)
+
+		result := <-execResult
+
+		assert.NoError(t, result.Err)
+		assert.Contains(t, string(result.Stdout), "License")
+		assert.EqualValues(t, 0, result.ExitCode)
+	})
+
 	t.Run("ExecuteMultilineEnvExport", func(t *testing.T) {
 		t.Parallel()
 
@@ -621,6 +652,44 @@ func Test_runnerService(t *testing.T) {
 		assert.Equal(t, "LICENSE: "+string(expected), string(result.Stdout))
 		assert.EqualValues(t, 0, result.ExitCode)
 	})
+
+	t.Run("ExecuteDirectoryChange", func(t *testing.T) {
+		t.Parallel()
+
+		session, err := client.CreateSession(context.Background(), &runnerv1.CreateSessionRequest{
+			ProgramName: "bash",
+			Directory:   "../..",
+		})
+		require.NoError(t, err)
+
+		execute := func(req *runnerv1.ExecuteRequest) executeResult {
+			stream, err := client.Execute(context.Background())
+			require.NoError(t, err)
+
+			execResult := make(chan executeResult)
+			go getExecuteResult(stream, execResult)
+
+			req.SessionId = session.Session.Id
+			require.NoError(t, stream.Send(req))
+
+			result := <-execResult
+			require.NoError(t, result.Err)
+			require.EqualValues(t, 0, result.ExitCode)
+			return result
+		}
+
+		execute(&runnerv1.ExecuteRequest{Commands: []string{"cd internal"}, IgnoreDirectoryChange: true})
+		result := execute(&runnerv1.ExecuteRequest{Commands: []string{"ls LICENSE"}})
+		assert.Equal(t, "LICENSE\n", string(result.Stdout))
+
+		execute(&runnerv1.ExecuteRequest{Commands: []string{"cd internal"}})
+		result = execute(&runnerv1.ExecuteRequest{Commands: []string{"ls -d runner"}})
+		assert.Equal(t, "runner\n", string(result.Stdout))
+
+		resp, err := client.GetSession(context.Background(), &runnerv1.GetSessionRequest{Id: session.Session.Id})
+		require.NoError(t, err)
+		assert.Equal(t, "internal", filepath.Base(resp.Session.Directory))
+	})
 }
 
 func Test_readLoop(t *testing.T) {
@@ -1,26 +1,43 @@
 package runner
 
 import (
+	"sync"
+
 	"github.com/rs/xid"
 	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
 )
 
 // Session is an abstract entity separate from
 // an execution. Currently, its main role is to
-// keep track of environment variables.
+// keep track of environment variables and jobs
+// running in the background.
 type Session struct {
 	ID       string
 	Metadata map[string]string
 
+	// ProgramName is a default program used to execute
+	// shell commands. If empty, $SHELL is used.
+	ProgramName string
+	// Dir is a default directory to execute commands in.
+	Dir string
+
 	envStore *envStore
-	logger   *zap.Logger
+	// initialEnvs are the environment variables
+	// with which the session was created.
+	initialEnvs *envStore
+	logger      *zap.Logger
+
+	jobsMu sync.Mutex
+	jobs   []*Job
 }
 
 func NewSession(envs []string, logger *zap.Logger) *Session {
 	s := &Session{
-		ID:       xid.New().String(),
-		envStore: newEnvStore(envs...),
-		logger:   logger,
+		ID:          xid.New().String(),
+		envStore:    newEnvStore(envs...),
+		initialEnvs: newEnvStore(envs...),
+		logger:      logger,
 	}
 	return s
 }
@@ -29,6 +46,38 @@ func (s *Session) AddEnvs(envs []string) {
 	s.envStore.Add(envs...)
 }
 
+// DeleteEnvs unsets the environment variables with the given names.
+func (s *Session) DeleteEnvs(names ...string) {
+	s.envStore.Delete(names...)
+}
+
+// EnvChanges returns the environment variables set or updated
+// and names of the ones unset since the session was created.
+// PWD and OLDPWD are omitted as the directory is kept in Dir.
+func (s *Session) EnvChanges() (newOrUpdated, deleted []string) {
+	newOrUpdated, _, deleted = diffEnvStores(s.initialEnvs, s.envStore)
+	newOrUpdated, deleted = withoutDirEnvs(newOrUpdated), withoutDirEnvs(deleted)
+	slices.Sort(newOrUpdated)
+	slices.Sort(deleted)
+	return
+}
+
 func (s *Session) Envs() []string {
 	return s.envStore.Values()
 }
+
+// LookupEnv returns the value of the environment variable
+// stored in the session.
+func (s *Session) LookupEnv(name string) (string, bool) {
+	return s.envStore.Get(name)
+}
+
+func withoutDirEnvs(envs []string) []string {
+	result := envs[:0]
+	for _, env := range envs {
+		if name, _ := splitEnv(env); !slices.Contains(dirEnvs, name) {
+			result = append(result, env)
+		}
+	}
+	return result
+}
@@ -0,0 +1,31 @@
+package runner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestSession_EnvChanges(t *testing.T) {
+	sess := NewSession([]string{"HOME=/home/runme", "PATH=/bin", "TOKEN=old"}, zap.NewNop())
+
+	newOrUpdated, deleted := sess.EnvChanges()
+	assert.Empty(t, newOrUpdated)
+	assert.Empty(t, deleted)
+
+	sess.AddEnvs([]string{"TOKEN=new", "USER=runme", "PATH=/bin"})
+	sess.DeleteEnvs("HOME")
+
+	newOrUpdated, deleted = sess.EnvChanges()
+	assert.Equal(t, []string{"TOKEN=new", "USER=runme"}, newOrUpdated)
+	assert.Equal(t, []string{"HOME"}, deleted)
+	_, ok := sess.LookupEnv("HOME")
+	assert.False(t, ok)
+
+	sess.AddEnvs([]string{"PWD=/tmp", "OLDPWD=/"})
+
+	newOrUpdated, deleted = sess.EnvChanges()
+	assert.Equal(t, []string{"TOKEN=new", "USER=runme"}, newOrUpdated)
+	assert.Equal(t, []string{"HOME"}, deleted)
+}
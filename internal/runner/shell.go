@@ -28,6 +28,9 @@ func (s Shell) ProgramPath() string {
 	if !ok {
 		shell = "sh"
 	}
+	if s.Session != nil && s.Session.ProgramName != "" {
+		shell = s.Session.ProgramName
+	}
 	if path, err := exec.LookPath(shell); err == nil {
 		return path
 	}
@@ -48,30 +51,40 @@ func (s Shell) DryRun(ctx context.Context, w io.Writer) {
 }
 
 func (s Shell) Run(ctx context.Context) error {
-	cmd, err := newCommand(
-		&commandConfig{
-			ProgramName: s.ProgramPath(),
-			Directory:   s.Dir,
-			Session:     s.Session,
-			Tty:         s.Tty,
-			Stdin:       s.Stdin,
-			Stdout:      s.Stdout,
-			Stderr:      s.Stderr,
-			IsShell:     true,
-			Commands:    s.Cmds,
-			Script:      "",
-			Logger:      s.Logger,
-		},
-	)
-	if err != nil {
-		return err
-	}
-	return s.run(ctx, cmd)
+	return s.retry(ctx, func(ctx context.Context) error {
+		cmd, err := newCommand(
+			&commandConfig{
+				ProgramName: s.ProgramPath(),
+				Directory:   s.Dir,
+				Session:     s.Session,
+				Tty:         s.Tty,
+				Stdin:       s.Stdin,
+				Stdout:      s.Stdout,
+				Stderr:      s.Stderr,
+				IsShell:     true,
+				Commands:    s.Cmds,
+				Script:      "",
+				Logger:      s.Logger,
+
+				IgnoreDirChange: s.IgnoreDirChange,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		return s.run(ctx, cmd)
+	})
 }
 
-func (s Shell) run(ctx context.Context, cmd *command) error {
+// run starts the command and waits for it. It is shared
+// by executables running commands in the session.
+func (c *ExecutableConfig) run(ctx context.Context, cmd *command) error {
+	if c.Background {
+		return errors.Wrapf(c.Session.startJob(c.Name, cmd), "failed to start job %q", c.Name)
+	}
+
 	opts := &startOpts{}
-	if s.Tty {
+	if c.Tty {
 		opts.DisableEcho = true
 	}
 
@@ -79,13 +92,26 @@ func (s Shell) run(ctx context.Context, cmd *command) error {
 		return err
 	}
 
+	// exec.CommandContext kills only the process when the context
+	// is done, for example, on timeout. Processes started by it
+	// could keep running, so the whole process group is killed.
+	exited := make(chan struct{})
+	defer close(exited)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = cmd.Kill()
+		case <-exited:
+		}
+	}()
+
 	if err := cmd.Wait(); err != nil {
 		var exiterr *exec.ExitError
 		// Ignore errors caused by SIGINT.
 		if errors.As(err, &exiterr) && exiterr.ProcessState.Sys().(syscall.WaitStatus).Signal() != os.Kill {
 			msg := "failed to run command"
-			if len(s.Name) > 0 {
-				msg += " " + strconv.Quote(s.Name)
+			if len(c.Name) > 0 {
+				msg += " " + strconv.Quote(c.Name)
 			}
 			return errors.Wrap(err, msg)
 		}
@@ -29,23 +29,27 @@ func (s ShellRaw) DryRun(ctx context.Context, w io.Writer) {
 }
 
 func (s ShellRaw) Run(ctx context.Context) error {
-	cmd, err := newCommand(
-		&commandConfig{
-			ProgramName: s.ProgramPath(),
-			Directory:   s.Dir,
-			Session:     s.Session,
-			Tty:         s.Tty,
-			Stdin:       s.Stdin,
-			Stdout:      s.Stdout,
-			Stderr:      s.Stderr,
-			IsShell:     true,
-			Commands:    nil,
-			Script:      strings.Join(s.Cmds, "\n"),
-			Logger:      s.Logger,
-		},
-	)
-	if err != nil {
-		return err
-	}
-	return s.run(ctx, cmd)
+	return s.retry(ctx, func(ctx context.Context) error {
+		cmd, err := newCommand(
+			&commandConfig{
+				ProgramName: s.ProgramPath(),
+				Directory:   s.Dir,
+				Session:     s.Session,
+				Tty:         s.Tty,
+				Stdin:       s.Stdin,
+				Stdout:      s.Stdout,
+				Stderr:      s.Stderr,
+				IsShell:     true,
+				Commands:    nil,
+				Script:      strings.Join(s.Cmds, "\n"),
+				Logger:      s.Logger,
+
+				IgnoreDirChange: s.IgnoreDirChange,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		return s.run(ctx, cmd)
+	})
 }
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/pkg/errors"
 	"github.com/stateful/runme/internal/cmd"
+	"github.com/stateful/runme/internal/runner"
 	"github.com/stateful/runme/internal/version"
 )
 
@@ -13,6 +15,10 @@ func root() int {
 	root.Version = fmt.Sprintf("%s (%s) on %s", version.BuildVersion, version.Commit, version.BuildDate)
 	if err := root.Execute(); err != nil {
 		_, _ = fmt.Fprintln(os.Stderr, err.Error())
+		var timeoutErr *runner.TimeoutError
+		if errors.As(err, &timeoutErr) {
+			return timeoutErr.ExitCode()
+		}
 		return 1
 	}
 	return 0